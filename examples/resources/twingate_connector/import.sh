@@ -1 +1,3 @@
-terraform import twingate_connector.aws_connector Q29ubmVjdG9yOjI2NzM=
\ No newline at end of file
+terraform import twingate_connector.aws_connector Q29ubmVjdG9yOjI2NzM=
+# or by the name of the Remote Network and the name of the Connector
+terraform import twingate_connector.aws_connector "aws_remote_network/aws-connector"
\ No newline at end of file
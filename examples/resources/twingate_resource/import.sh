@@ -1 +1,3 @@
-terraform import twingate_resource.resource UmVzb3VyY2U6MzQwNDQ3
\ No newline at end of file
+terraform import twingate_resource.resource UmVzb3VyY2U6MzQwNDQ3
+# or by the name of the Remote Network and the name of the Resource
+terraform import twingate_resource.resource "aws_remote_network/network"
\ No newline at end of file
@@ -0,0 +1,49 @@
+provider "twingate" {
+  api_token = "1234567890abcdef"
+  network   = "mynetwork"
+}
+
+resource "twingate_remote_network" "aws_network" {
+  name = "aws_remote_network"
+}
+
+resource "twingate_group" "aws" {
+  name = "aws_group"
+}
+
+locals {
+  hosts = {
+    "web-01" = "10.0.1.10"
+    "web-02" = "10.0.1.11"
+    "db-01"  = "10.0.2.10"
+  }
+}
+
+resource "twingate_resource_set" "hosts" {
+  max_concurrency = 8
+
+  dynamic "resources" {
+    for_each = local.hosts
+    content {
+      key               = resources.key
+      name              = resources.key
+      address           = resources.value
+      remote_network_id = twingate_remote_network.aws_network.id
+
+      protocols {
+        allow_icmp = true
+        tcp {
+          policy   = "RESTRICTED"
+          services = ["ssh", "https"]
+        }
+        udp {
+          policy = "DENY_ALL"
+        }
+      }
+
+      access {
+        group_ids = [twingate_group.aws.id]
+      }
+    }
+  }
+}
@@ -0,0 +1,3 @@
+terraform import twingate_service_account.github_actions_prod U2VydmljZUFjY291bnQ6MzQwNDQ3
+# or by name
+terraform import twingate_service_account.github_actions_prod "name:Github Actions PROD"
\ No newline at end of file
@@ -0,0 +1,3 @@
+terraform import twingate_service_account_key.github_key U2VydmljZUFjY291bnRLZXk6MzQwNDQ3
+# or by the name of the Service Account and the name of the key
+terraform import twingate_service_account_key.github_key "Github Actions PROD/Github Actions PROD key"
\ No newline at end of file
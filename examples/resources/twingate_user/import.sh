@@ -0,0 +1,3 @@
+terraform import twingate_user.user VXNlcjozNDA0NDc=
+# or by email
+terraform import twingate_user.user "email:sample@company.com"
\ No newline at end of file
@@ -1,7 +1,12 @@
 package main
 
 import (
+	"context"
+	"fmt"
+	"os"
+
 	"github.com/Twingate/terraform-provider-twingate/twingate"
+	"github.com/Twingate/terraform-provider-twingate/twingate/cmd"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/plugin"
@@ -12,9 +17,27 @@ var (
 )
 
 func main() {
+	if len(os.Args) > 1 && cmd.IsCommand(os.Args[1]) {
+		if err := cmd.Run(context.Background(), version, os.Args[1:], os.Stdout); err != nil {
+			fmt.Fprintln(os.Stderr, "Error:", err)
+			os.Exit(1)
+		}
+
+		return
+	}
+
+	shutdownTracing, err := twingate.SetupTracing(context.Background(), version)
+	if err != nil {
+		fmt.Fprintln(os.Stderr, "Error:", err)
+		os.Exit(1)
+	}
+
 	plugin.Serve(&plugin.ServeOpts{
 		ProviderFunc: func() *schema.Provider {
 			return twingate.Provider(version)
 		},
 	})
+
+	// export the remaining spans before the provider exits
+	_ = shutdownTracing(context.Background())
 }
@@ -0,0 +1,187 @@
+package cmd
+
+import (
+	"context"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"sort"
+	"strconv"
+	"time"
+
+	"github.com/Twingate/terraform-provider-twingate/twingate"
+	"github.com/Twingate/terraform-provider-twingate/twingate/internal/client"
+	"github.com/Twingate/terraform-provider-twingate/twingate/sdk"
+)
+
+var ErrUnknownCommand = errors.New("unknown command")
+
+// command - subcommand of the provider binary, to work with a Twingate network outside of Terraform.
+type command struct {
+	name        string
+	description string
+	run         func(ctx context.Context, env *environment, args []string) error
+}
+
+// environment - shared by all commands.
+type environment struct {
+	version string
+	stdout  io.Writer
+}
+
+func commands() map[string]*command {
+	list := []*command{
+		exportCommand(),
+		reportCommand(),
+		snapshotCommand(),
+		restoreCommand(),
+		replicateCommand(),
+		driftCommand(),
+	}
+
+	result := make(map[string]*command, len(list))
+	for _, cmd := range list {
+		result[cmd.name] = cmd
+	}
+
+	return result
+}
+
+// IsCommand - reports whether the provider binary was started as a command rather than as a Terraform plugin.
+func IsCommand(name string) bool {
+	_, ok := commands()[name]
+
+	return ok || name == "help"
+}
+
+// Run - runs the command given by the first argument.
+func Run(ctx context.Context, version string, args []string, stdout io.Writer) error {
+	env := &environment{version: version, stdout: stdout}
+
+	if len(args) == 0 || args[0] == "help" {
+		printUsage(stdout)
+
+		return nil
+	}
+
+	cmd, ok := commands()[args[0]]
+	if !ok {
+		return fmt.Errorf("%w: %s", ErrUnknownCommand, args[0])
+	}
+
+	return cmd.run(ctx, env, args[1:])
+}
+
+func printUsage(out io.Writer) {
+	available := commands()
+
+	names := make([]string, 0, len(available))
+	for name := range available {
+		names = append(names, name)
+	}
+
+	sort.Strings(names)
+
+	fmt.Fprintln(out, "Usage: terraform-provider-twingate <command> [flags]")
+	fmt.Fprintln(out)
+	fmt.Fprintln(out, "Commands:")
+
+	for _, name := range names {
+		fmt.Fprintf(out, "  %-10s %s\n", name, available[name].description)
+	}
+}
+
+func newFlagSet(name string, env *environment) *flag.FlagSet {
+	flags := flag.NewFlagSet(name, flag.ContinueOnError)
+	flags.SetOutput(env.stdout)
+
+	return flags
+}
+
+// clientFlags - connection settings of a Twingate network, defaulting to the same environment variables as the provider.
+type clientFlags struct {
+	apiToken        string
+	apiTokenFile    string
+	apiTokenCommand string
+	network         string
+	url             string
+	httpTimeout     int
+	httpMaxRetry    int
+	transport       client.TransportConfig
+}
+
+// register - adds connection flags, `prefix` allows to configure more than one network, e.g. `source-` and `target-`.
+func (f *clientFlags) register(flags *flag.FlagSet, prefix string) {
+	flags.StringVar(&f.apiToken, prefix+"api-token", os.Getenv(twingate.EnvAPIToken),
+		fmt.Sprintf("the access key for API operations, defaults to %s env var", twingate.EnvAPIToken))
+	flags.StringVar(&f.apiTokenFile, prefix+"api-token-file", os.Getenv(twingate.EnvAPITokenFile),
+		fmt.Sprintf("file containing the access key, defaults to %s env var", twingate.EnvAPITokenFile))
+	flags.StringVar(&f.apiTokenCommand, prefix+"api-token-command", os.Getenv(twingate.EnvAPITokenCommand),
+		fmt.Sprintf("command printing the access key, defaults to %s env var", twingate.EnvAPITokenCommand))
+	flags.StringVar(&f.network, prefix+"network", os.Getenv(twingate.EnvNetwork),
+		fmt.Sprintf("Twingate network ID, defaults to %s env var", twingate.EnvNetwork))
+	flags.StringVar(&f.url, prefix+"url", envOrDefault(twingate.EnvURL, twingate.DefaultURL),
+		fmt.Sprintf("Twingate URL, defaults to %s env var or %s", twingate.EnvURL, twingate.DefaultURL))
+	flags.IntVar(&f.httpTimeout, prefix+"http-timeout", envIntOrDefault(twingate.EnvHTTPTimeout, twingate.DefaultHTTPTimeout),
+		"time limit in seconds for the http requests made")
+	flags.IntVar(&f.httpMaxRetry, prefix+"http-max-retry", envIntOrDefault(twingate.EnvHTTPMaxRetry, twingate.DefaultHTTPMaxRetry),
+		"retry limit for the http requests made")
+	flags.StringVar(&f.transport.ProxyURL, prefix+"proxy-url", os.Getenv(twingate.EnvProxyURL),
+		fmt.Sprintf("proxy used for all requests, defaults to %s env var, then HTTP_PROXY and HTTPS_PROXY", twingate.EnvProxyURL))
+	flags.StringVar(&f.transport.CACertFile, prefix+"ca-cert-file", os.Getenv(twingate.EnvCACertFile),
+		fmt.Sprintf("PEM file of CA certificates trusted in addition to the system roots, defaults to %s env var", twingate.EnvCACertFile))
+	flags.StringVar(&f.transport.CACertPEM, prefix+"ca-cert-pem", os.Getenv(twingate.EnvCACertPEM),
+		fmt.Sprintf("PEM encoded CA certificates trusted in addition to the system roots, defaults to %s env var", twingate.EnvCACertPEM))
+	flags.BoolVar(&f.transport.InsecureSkipVerify, prefix+"insecure-skip-verify", envBool(twingate.EnvInsecureSkipVerify),
+		fmt.Sprintf("don't verify the TLS certificate of the Twingate API, only meant for debugging, defaults to %s env var", twingate.EnvInsecureSkipVerify))
+	flags.StringVar(&f.transport.ClientCertFile, prefix+"client-cert-file", os.Getenv(twingate.EnvClientCertFile),
+		fmt.Sprintf("PEM file of the client certificate for mutual TLS, defaults to %s env var", twingate.EnvClientCertFile))
+	flags.StringVar(&f.transport.ClientKeyFile, prefix+"client-key-file", os.Getenv(twingate.EnvClientKeyFile),
+		fmt.Sprintf("PEM file of the private key of the client certificate, defaults to %s env var", twingate.EnvClientKeyFile))
+	flags.StringVar(&f.transport.ClientCertPEM, prefix+"client-cert-pem", os.Getenv(twingate.EnvClientCertPEM),
+		fmt.Sprintf("PEM encoded client certificate, defaults to %s env var", twingate.EnvClientCertPEM))
+	flags.StringVar(&f.transport.ClientKeyPEM, prefix+"client-key-pem", os.Getenv(twingate.EnvClientKeyPEM),
+		fmt.Sprintf("PEM encoded private key of the client certificate, defaults to %s env var", twingate.EnvClientKeyPEM))
+}
+
+func (f *clientFlags) newClient(version string) (*sdk.Client, error) {
+	return sdk.NewClient(f.network, //nolint:wrapcheck
+		sdk.WithURL(f.url),
+		sdk.WithAPIToken(f.apiToken),
+		sdk.WithAPITokenFile(f.apiTokenFile),
+		sdk.WithAPITokenCommand(f.apiTokenCommand),
+		sdk.WithHTTPTimeout(time.Duration(f.httpTimeout)*time.Second),
+		sdk.WithHTTPMaxRetry(f.httpMaxRetry),
+		sdk.WithProxyURL(f.transport.ProxyURL),
+		sdk.WithCACertFile(f.transport.CACertFile),
+		sdk.WithCACertPEM(f.transport.CACertPEM),
+		sdk.WithInsecureSkipVerify(f.transport.InsecureSkipVerify),
+		sdk.WithClientCertFiles(f.transport.ClientCertFile, f.transport.ClientKeyFile),
+		sdk.WithClientCertPEM(f.transport.ClientCertPEM, f.transport.ClientKeyPEM),
+		sdk.WithVersion(version))
+}
+
+func envOrDefault(key, defaultValue string) string {
+	if val := os.Getenv(key); val != "" {
+		return val
+	}
+
+	return defaultValue
+}
+
+func envBool(key string) bool {
+	val, _ := strconv.ParseBool(os.Getenv(key))
+
+	return val
+}
+
+func envIntOrDefault(key, defaultValue string) int {
+	val, err := strconv.Atoi(envOrDefault(key, defaultValue))
+	if err != nil {
+		val, _ = strconv.Atoi(defaultValue)
+	}
+
+	return val
+}
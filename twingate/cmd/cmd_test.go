@@ -0,0 +1,35 @@
+package cmd
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/Twingate/terraform-provider-twingate/twingate/internal/client"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestClientFlagsTransport(t *testing.T) {
+	var (
+		out   bytes.Buffer
+		f     clientFlags
+		flags = newFlagSet("test", &environment{stdout: &out})
+	)
+
+	f.register(flags, "target-")
+
+	assert.NoError(t, flags.Parse([]string{
+		"-target-network", "acme",
+		"-target-proxy-url", "ftp://proxy.internal",
+		"-target-insecure-skip-verify",
+		"-target-client-cert-file", "cert.pem",
+	}))
+
+	assert.Equal(t, client.TransportConfig{
+		ProxyURL:           "ftp://proxy.internal",
+		InsecureSkipVerify: true,
+		ClientCertFile:     "cert.pem",
+	}, f.transport)
+
+	_, err := f.newClient("test")
+	assert.ErrorIs(t, err, client.ErrInvalidProxyURL)
+}
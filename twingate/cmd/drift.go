@@ -0,0 +1,611 @@
+package cmd
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/Twingate/terraform-provider-twingate/twingate/internal/attr"
+	"github.com/Twingate/terraform-provider-twingate/twingate/internal/model"
+	"github.com/Twingate/terraform-provider-twingate/twingate/internal/provider/resource"
+)
+
+const (
+	formatText = "text"
+
+	stateModeManaged = "managed"
+)
+
+var ErrDriftDetected = errors.New("drift detected")
+
+func driftCommand() *command {
+	return &command{
+		name:        "drift",
+		description: "compare a Terraform state file to the network and report unmanaged, missing and changed objects",
+		run:         runDrift,
+	}
+}
+
+func runDrift(ctx context.Context, env *environment, args []string) error {
+	flags := newFlagSet("drift", env)
+
+	var conn clientFlags
+	conn.register(flags, "")
+
+	statePath := flags.String("state", "terraform.tfstate", "Terraform state file, e.g. from `terraform state pull`")
+	format := flags.String("format", formatText, "output format: text or json")
+	failOnDrift := flags.Bool("fail-on-drift", false, "exit with an error when any drift is detected")
+
+	if err := flags.Parse(args); err != nil {
+		return err //nolint:wrapcheck
+	}
+
+	write, ok := driftWriters()[*format]
+	if !ok {
+		return fmt.Errorf("%w: %s", ErrUnknownFormat, *format)
+	}
+
+	file, err := os.Open(*statePath)
+	if err != nil {
+		return fmt.Errorf("failed to open %s: %w", *statePath, err)
+	}
+
+	managed, err := readState(file)
+	_ = file.Close()
+
+	if err != nil {
+		return err
+	}
+
+	c, err := conn.newClient(env.version)
+	if err != nil {
+		return err
+	}
+
+	network, err := loadTenant(ctx, c)
+	if err != nil {
+		return err
+	}
+
+	report := detectDrift(managed, liveObjects(network))
+
+	if err := write(env.stdout, report); err != nil {
+		return err
+	}
+
+	if *failOnDrift && report.hasDrift() {
+		return ErrDriftDetected
+	}
+
+	return nil
+}
+
+// stateObject - a Twingate object managed by Terraform, with the attributes recorded in the state.
+type stateObject struct {
+	kind       string
+	id         string
+	address    string
+	attributes map[string]interface{}
+}
+
+type tfState struct {
+	Version   int               `json:"version"`
+	Resources []tfStateResource `json:"resources"`
+}
+
+type tfStateResource struct {
+	Module    string            `json:"module"`
+	Mode      string            `json:"mode"`
+	Type      string            `json:"type"`
+	Name      string            `json:"name"`
+	Instances []tfStateInstance `json:"instances"`
+}
+
+type tfStateInstance struct {
+	IndexKey   interface{}            `json:"index_key"`
+	Attributes map[string]interface{} `json:"attributes"`
+}
+
+// readState - reads Twingate objects from the state file, resources of a resource set are reported
+// as separate `twingate_resource` objects.
+func readState(in io.Reader) ([]*stateObject, error) {
+	var state tfState
+	if err := json.NewDecoder(in).Decode(&state); err != nil {
+		return nil, fmt.Errorf("failed to read state: %w", err)
+	}
+
+	objects := make([]*stateObject, 0, len(state.Resources))
+
+	for _, res := range state.Resources {
+		if res.Mode != stateModeManaged || !strings.HasPrefix(res.Type, "twingate_") {
+			continue
+		}
+
+		for _, instance := range res.Instances {
+			address := instanceAddress(res, instance)
+
+			if res.Type == resource.TwingateResourceSet {
+				objects = append(objects, resourceSetObjects(address, instance.Attributes)...)
+
+				continue
+			}
+
+			if _, ok := driftKinds()[res.Type]; !ok {
+				continue
+			}
+
+			objects = append(objects, &stateObject{
+				kind:       res.Type,
+				id:         stringAttribute(instance.Attributes, attr.ID),
+				address:    address,
+				attributes: instance.Attributes,
+			})
+		}
+	}
+
+	return objects, nil
+}
+
+func instanceAddress(res tfStateResource, instance tfStateInstance) string {
+	address := res.Type + "." + res.Name
+	if res.Module != "" {
+		address = res.Module + "." + address
+	}
+
+	switch key := instance.IndexKey.(type) {
+	case string:
+		address += fmt.Sprintf("[%q]", key)
+	case float64:
+		address += fmt.Sprintf("[%d]", int(key))
+	}
+
+	return address
+}
+
+func resourceSetObjects(address string, attributes map[string]interface{}) []*stateObject {
+	ids, _ := attributes[attr.ResourceIDs].(map[string]interface{})
+	entries, _ := attributes[attr.Resources].([]interface{})
+
+	objects := make([]*stateObject, 0, len(entries))
+
+	for _, item := range entries {
+		entry, ok := item.(map[string]interface{})
+		if !ok {
+			continue
+		}
+
+		key := stringAttribute(entry, attr.Key)
+		id, _ := ids[key].(string)
+
+		objects = append(objects, &stateObject{
+			kind:       resource.TwingateResource,
+			id:         id,
+			address:    fmt.Sprintf("%s.%s[%q]", address, attr.Resources, key),
+			attributes: entry,
+		})
+	}
+
+	return objects
+}
+
+// liveObject - a Twingate object of the network, with fields formatted the same way as the state attributes.
+type liveObject struct {
+	kind   string
+	id     string
+	name   string
+	fields map[string]string
+}
+
+// driftKinds - resource types compared by drift, with the fields of their state attributes to compare.
+func driftKinds() map[string]func(attributes map[string]interface{}) map[string]string {
+	return map[string]func(attributes map[string]interface{}) map[string]string{
+		resource.TwingateRemoteNetwork:  flatStateFields(attr.Name, attr.Location),
+		resource.TwingateConnector:      flatStateFields(attr.Name, attr.RemoteNetworkID, attr.StatusUpdatesEnabled),
+		resource.TwingateGroup:          groupStateFields,
+		resource.TwingateUser:           flatStateFields(attr.Email, attr.FirstName, attr.LastName, attr.Role),
+		resource.TwingateServiceAccount: flatStateFields(attr.Name),
+		resource.TwingateResource:       resourceStateFields,
+	}
+}
+
+func liveObjects(network *tenant) []*liveObject {
+	objects := make([]*liveObject, 0)
+
+	for _, item := range network.RemoteNetworks {
+		objects = append(objects, &liveObject{kind: resource.TwingateRemoteNetwork, id: item.ID, name: item.Name, fields: map[string]string{
+			attr.Name:     item.Name,
+			attr.Location: item.Location,
+		}})
+	}
+
+	for _, item := range network.Connectors {
+		fields := map[string]string{
+			attr.Name:            item.Name,
+			attr.RemoteNetworkID: item.NetworkID,
+		}
+
+		if item.StatusUpdatesEnabled != nil {
+			fields[attr.StatusUpdatesEnabled] = strconv.FormatBool(*item.StatusUpdatesEnabled)
+		}
+
+		objects = append(objects, &liveObject{kind: resource.TwingateConnector, id: item.ID, name: item.Name, fields: fields})
+	}
+
+	for _, item := range network.Groups {
+		// only manual groups can be managed by Terraform
+		if item.Type != model.GroupTypeManual {
+			continue
+		}
+
+		objects = append(objects, &liveObject{kind: resource.TwingateGroup, id: item.ID, name: item.Name, fields: map[string]string{
+			attr.Name:             item.Name,
+			attr.UserIDs:          formatList(item.Users),
+			attr.SecurityPolicyID: item.SecurityPolicyID,
+		}})
+	}
+
+	for _, item := range network.Users {
+		// synced users are managed by the identity provider
+		if item.Type != model.UserTypeManual {
+			continue
+		}
+
+		objects = append(objects, &liveObject{kind: resource.TwingateUser, id: item.ID, name: item.Email, fields: map[string]string{
+			attr.Email:     item.Email,
+			attr.FirstName: item.FirstName,
+			attr.LastName:  item.LastName,
+			attr.Role:      item.Role,
+		}})
+	}
+
+	for _, item := range network.ServiceAccounts {
+		objects = append(objects, &liveObject{kind: resource.TwingateServiceAccount, id: item.ID, name: item.Name, fields: map[string]string{
+			attr.Name: item.Name,
+		}})
+	}
+
+	for _, item := range network.Resources {
+		objects = append(objects, &liveObject{kind: resource.TwingateResource, id: item.ID, name: item.Name, fields: liveResourceFields(item)})
+	}
+
+	return objects
+}
+
+func liveResourceFields(item *model.Resource) map[string]string {
+	fields := map[string]string{
+		attr.Name:              item.Name,
+		attr.Address:           item.Address,
+		attr.RemoteNetworkID:   item.RemoteNetworkID,
+		attr.Alias:             stringValue(item.Alias),
+		attr.Protocols:         formatProtocols(item.Protocols),
+		attr.GroupIDs:          formatList(item.Groups),
+		attr.ServiceAccountIDs: formatList(item.ServiceAccounts),
+	}
+
+	if item.IsVisible != nil {
+		fields[attr.IsVisible] = strconv.FormatBool(*item.IsVisible)
+	}
+
+	if item.IsBrowserShortcutEnabled != nil {
+		fields[attr.IsBrowserShortcutEnabled] = strconv.FormatBool(*item.IsBrowserShortcutEnabled)
+	}
+
+	return fields
+}
+
+func flatStateFields(names ...string) func(attributes map[string]interface{}) map[string]string {
+	return func(attributes map[string]interface{}) map[string]string {
+		fields := make(map[string]string, len(names))
+
+		for _, name := range names {
+			if value, ok := attributes[name]; ok && value != nil {
+				fields[name] = formatAttribute(value)
+			}
+		}
+
+		return fields
+	}
+}
+
+func groupStateFields(attributes map[string]interface{}) map[string]string {
+	fields := flatStateFields(attr.Name)(attributes)
+
+	// non-authoritative groups only track users managed by Terraform
+	if authoritative, _ := attributes[attr.IsAuthoritative].(bool); authoritative {
+		fields[attr.UserIDs] = formatAttribute(attributes[attr.UserIDs])
+	}
+
+	if policy := stringAttribute(attributes, attr.SecurityPolicyID); policy != "" {
+		fields[attr.SecurityPolicyID] = policy
+	}
+
+	return fields
+}
+
+func resourceStateFields(attributes map[string]interface{}) map[string]string {
+	fields := flatStateFields(attr.Name, attr.Address, attr.RemoteNetworkID, attr.IsVisible, attr.IsBrowserShortcutEnabled)(attributes)
+
+	if alias, ok := attributes[attr.Alias]; ok {
+		fields[attr.Alias] = formatAttribute(alias)
+	}
+
+	if protocols, ok := stateProtocols(attributes[attr.Protocols]); ok {
+		fields[attr.Protocols] = formatProtocols(protocols)
+	}
+
+	// resources of a resource set and non-authoritative resources don't track all assignments
+	if authoritative, ok := attributes[attr.IsAuthoritative].(bool); !ok || !authoritative {
+		return fields
+	}
+
+	// the live resource includes the groups and service accounts of active grants, which the state keeps apart
+	access := firstBlock(attributes[attr.Access])
+	grants := append(stateGrants(access[attr.GroupGrant], model.GrantTypeGroup, attr.GroupID),
+		stateGrants(access[attr.ServiceAccountGrant], model.GrantTypeServiceAccount, attr.ServiceAccountID)...)
+	now := time.Now()
+
+	fields[attr.GroupIDs] = formatList(append(stateIDs(access[attr.GroupIDs]),
+		model.ActiveGrantIDs(grants, model.GrantTypeGroup, now)...))
+	fields[attr.ServiceAccountIDs] = formatList(append(stateIDs(access[attr.ServiceAccountIDs]),
+		model.ActiveGrantIDs(grants, model.GrantTypeServiceAccount, now)...))
+
+	return fields
+}
+
+func stateIDs(value interface{}) []string {
+	values, _ := value.([]interface{})
+	ids := make([]string, 0, len(values))
+
+	for _, id := range values {
+		ids = append(ids, fmt.Sprint(id))
+	}
+
+	return ids
+}
+
+func stateGrants(value interface{}, grantType, idAttribute string) []*model.Grant {
+	blocks, _ := value.([]interface{})
+	grants := make([]*model.Grant, 0, len(blocks))
+
+	for _, block := range blocks {
+		grant, _ := block.(map[string]interface{})
+
+		grants = append(grants, &model.Grant{
+			Type:      grantType,
+			ID:        stringAttribute(grant, idAttribute),
+			ExpiresAt: stringAttribute(grant, attr.ExpiresAt),
+		})
+	}
+
+	return grants
+}
+
+// stateProtocols - protocols block of the state, with service presets expanded into their ports.
+func stateProtocols(value interface{}) (*model.Protocols, bool) {
+	block := firstBlock(value)
+	if block == nil {
+		return model.DefaultProtocols(), true
+	}
+
+	allowIcmp, _ := block[attr.AllowIcmp].(bool)
+	protocols := &model.Protocols{AllowIcmp: allowIcmp}
+
+	for transport, target := range map[string]**model.Protocol{attr.TCP: &protocols.TCP, attr.UDP: &protocols.UDP} {
+		protocolBlock := firstBlock(block[transport])
+		if protocolBlock == nil {
+			*target = model.DefaultProtocol()
+
+			continue
+		}
+
+		ports := make([]*model.PortRange, 0)
+
+		values, _ := protocolBlock[attr.Ports].([]interface{})
+		for _, value := range values {
+			port, err := model.NewPortRange(fmt.Sprint(value))
+			if err != nil {
+				return nil, false
+			}
+
+			ports = append(ports, port)
+		}
+
+		*target = model.NewProtocol(stringAttribute(protocolBlock, attr.Policy), ports)
+
+		if services := stateIDs(protocolBlock[attr.Services]); len(services) > 0 {
+			servicePorts, err := model.ServicePorts(transport, services)
+			if err != nil {
+				return nil, false
+			}
+
+			(*target).ServicePorts = servicePorts
+		}
+	}
+
+	return protocols, true
+}
+
+// driftReport - machine readable result of the comparison.
+type driftReport struct {
+	Unmanaged []*driftObject `json:"unmanaged"`
+	Missing   []*driftObject `json:"missing"`
+	Changed   []*driftObject `json:"changed"`
+}
+
+type driftObject struct {
+	Type    string        `json:"type"`
+	ID      string        `json:"id"`
+	Name    string        `json:"name,omitempty"`
+	Address string        `json:"address,omitempty"`
+	Fields  []*driftField `json:"fields,omitempty"`
+}
+
+type driftField struct {
+	Field string `json:"field"`
+	State string `json:"state"`
+	Live  string `json:"live"`
+}
+
+func (r *driftReport) hasDrift() bool {
+	return len(r.Unmanaged) > 0 || len(r.Missing) > 0 || len(r.Changed) > 0
+}
+
+func detectDrift(managed []*stateObject, live []*liveObject) *driftReport {
+	report := &driftReport{
+		Unmanaged: make([]*driftObject, 0),
+		Missing:   make([]*driftObject, 0),
+		Changed:   make([]*driftObject, 0),
+	}
+
+	liveByID := make(map[string]*liveObject, len(live))
+	for _, object := range live {
+		liveByID[object.kind+"/"+object.id] = object
+	}
+
+	managedIDs := make(map[string]bool, len(managed))
+
+	for _, object := range managed {
+		key := object.kind + "/" + object.id
+		managedIDs[key] = true
+
+		liveObject, ok := liveByID[key]
+		if !ok {
+			report.Missing = append(report.Missing, &driftObject{
+				Type:    object.kind,
+				ID:      object.id,
+				Name:    stringAttribute(object.attributes, attr.Name),
+				Address: object.address,
+			})
+
+			continue
+		}
+
+		if fields := compareFields(driftKinds()[object.kind](object.attributes), liveObject.fields); len(fields) > 0 {
+			report.Changed = append(report.Changed, &driftObject{
+				Type:    object.kind,
+				ID:      object.id,
+				Name:    liveObject.name,
+				Address: object.address,
+				Fields:  fields,
+			})
+		}
+	}
+
+	for _, object := range live {
+		if !managedIDs[object.kind+"/"+object.id] {
+			report.Unmanaged = append(report.Unmanaged, &driftObject{Type: object.kind, ID: object.id, Name: object.name})
+		}
+	}
+
+	return report
+}
+
+// compareFields - differences of fields present both in the state and in the network.
+func compareFields(state, live map[string]string) []*driftField {
+	names := make([]string, 0, len(state))
+
+	for name := range state {
+		if _, ok := live[name]; ok && state[name] != live[name] {
+			names = append(names, name)
+		}
+	}
+
+	sort.Strings(names)
+
+	fields := make([]*driftField, 0, len(names))
+	for _, name := range names {
+		fields = append(fields, &driftField{Field: name, State: state[name], Live: live[name]})
+	}
+
+	return fields
+}
+
+func driftWriters() map[string]func(out io.Writer, report *driftReport) error {
+	return map[string]func(out io.Writer, report *driftReport) error{
+		formatText: writeDriftText,
+		formatJSON: writeDriftJSON,
+	}
+}
+
+func writeDriftJSON(out io.Writer, report *driftReport) error {
+	encoder := json.NewEncoder(out)
+	encoder.SetIndent("", "  ")
+
+	return encoder.Encode(report) //nolint:wrapcheck
+}
+
+func writeDriftText(out io.Writer, report *driftReport) error {
+	var builder strings.Builder
+
+	if !report.hasDrift() {
+		builder.WriteString("No drift detected.\n")
+	}
+
+	for _, object := range report.Unmanaged {
+		fmt.Fprintf(&builder, "+ %s %q (%s) is not managed by Terraform\n", object.Type, object.Name, object.ID)
+	}
+
+	for _, object := range report.Missing {
+		fmt.Fprintf(&builder, "- %s (%s) doesn't exist anymore\n", object.Address, object.ID)
+	}
+
+	for _, object := range report.Changed {
+		fmt.Fprintf(&builder, "~ %s (%s)\n", object.Address, object.ID)
+
+		for _, field := range object.Fields {
+			fmt.Fprintf(&builder, "    %s: %q -> %q\n", field.Field, field.State, field.Live)
+		}
+	}
+
+	_, err := io.WriteString(out, builder.String())
+
+	return err //nolint:wrapcheck
+}
+
+// formatAttribute - formats state values the same way as live fields, lists are sorted to ignore ordering.
+func formatAttribute(value interface{}) string {
+	switch typed := value.(type) {
+	case nil:
+		return ""
+	case []interface{}:
+		items := make([]string, 0, len(typed))
+		for _, item := range typed {
+			items = append(items, fmt.Sprint(item))
+		}
+
+		return formatList(items)
+	default:
+		return fmt.Sprint(typed)
+	}
+}
+
+func formatList(items []string) string {
+	sorted := append([]string(nil), items...)
+	sort.Strings(sorted)
+
+	return strings.Join(sorted, ", ")
+}
+
+func stringAttribute(attributes map[string]interface{}, name string) string {
+	value, _ := attributes[name].(string)
+
+	return value
+}
+
+func firstBlock(value interface{}) map[string]interface{} {
+	blocks, _ := value.([]interface{})
+	if len(blocks) == 0 {
+		return nil
+	}
+
+	block, _ := blocks[0].(map[string]interface{})
+
+	return block
+}
@@ -0,0 +1,172 @@
+package cmd
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+
+	"github.com/Twingate/terraform-provider-twingate/twingate/internal/model"
+	"github.com/stretchr/testify/assert"
+)
+
+const driftState = `{
+  "version": 4,
+  "resources": [
+    {
+      "mode": "managed",
+      "type": "twingate_remote_network",
+      "name": "office",
+      "instances": [{"attributes": {"id": "network-1", "name": "Office", "location": "OTHER"}}]
+    },
+    {
+      "mode": "data",
+      "type": "twingate_groups",
+      "name": "all",
+      "instances": [{"attributes": {"id": "groups"}}]
+    },
+    {
+      "mode": "managed",
+      "type": "twingate_group",
+      "name": "team",
+      "instances": [
+        {"index_key": "eng", "attributes": {"id": "group-1", "name": "Engineering", "is_authoritative": true, "user_ids": ["user-2", "user-1"]}},
+        {"index_key": "ops", "attributes": {"id": "group-9", "name": "Ops", "is_authoritative": false, "user_ids": []}}
+      ]
+    },
+    {
+      "module": "module.apps",
+      "mode": "managed",
+      "type": "twingate_resource",
+      "name": "db",
+      "instances": [{"attributes": {
+        "id": "resource-1", "name": "DB", "address": "db.internal", "remote_network_id": "network-1",
+        "alias": null, "is_visible": true, "is_authoritative": true,
+        "protocols": [{"allow_icmp": false, "tcp": [{"policy": "RESTRICTED", "ports": ["5432", "22"], "services": []}], "udp": [{"policy": "DENY_ALL", "ports": [], "services": []}]}],
+        "access": [{"group_ids": ["group-1"], "service_account_ids": []}]
+      }}]
+    },
+    {
+      "mode": "managed",
+      "type": "twingate_resource_set",
+      "name": "web",
+      "instances": [{"attributes": {
+        "id": "set",
+        "resource_ids": {"web": "resource-2"},
+        "resources": [{"key": "web", "name": "Web", "address": "web.old", "remote_network_id": "network-2", "protocols": []}]
+      }}]
+    }
+  ]
+}`
+
+func TestReadState(t *testing.T) {
+	objects, err := readState(strings.NewReader(driftState))
+	assert.NoError(t, err)
+
+	addresses := make([]string, 0, len(objects))
+	for _, object := range objects {
+		addresses = append(addresses, object.kind+" "+object.id+" "+object.address)
+	}
+
+	assert.Equal(t, []string{
+		`twingate_remote_network network-1 twingate_remote_network.office`,
+		`twingate_group group-1 twingate_group.team["eng"]`,
+		`twingate_group group-9 twingate_group.team["ops"]`,
+		`twingate_resource resource-1 module.apps.twingate_resource.db`,
+		`twingate_resource resource-2 twingate_resource_set.web.resources["web"]`,
+	}, addresses)
+}
+
+func TestDetectDrift(t *testing.T) {
+	objects, err := readState(strings.NewReader(driftState))
+	assert.NoError(t, err)
+
+	network := newReportTenant()
+	network.RemoteNetworks[0].Location = model.LocationOther
+	network.Groups[0].Type = model.GroupTypeManual
+	network.Groups[1].Type = model.GroupTypeManual
+	network.Users[0].Type = model.UserTypeManual
+	network.Users[1].Type = model.UserTypeSynced
+
+	report := detectDrift(objects, liveObjects(network))
+	assert.True(t, report.hasDrift())
+
+	var out bytes.Buffer
+
+	assert.NoError(t, writeDriftText(&out, report))
+	assert.Equal(t, `+ twingate_remote_network "AWS" (network-2) is not managed by Terraform
++ twingate_group "Admins" (group-2) is not managed by Terraform
++ twingate_user "alice@example.com" (user-1) is not managed by Terraform
++ twingate_service_account "CI" (account-1) is not managed by Terraform
+- twingate_group.team["ops"] (group-9) doesn't exist anymore
+~ module.apps.twingate_resource.db (resource-1)
+    group_ids: "group-1" -> "group-1, group-2"
+    service_account_ids: "" -> "account-1"
+~ twingate_resource_set.web.resources["web"] (resource-2)
+    address: "web.old" -> "web.internal"
+`, out.String())
+}
+
+func TestDetectNoDrift(t *testing.T) {
+	report := detectDrift(nil, nil)
+	assert.False(t, report.hasDrift())
+
+	var out bytes.Buffer
+
+	assert.NoError(t, writeDriftJSON(&out, report))
+	assert.JSONEq(t, `{"unmanaged": [], "missing": [], "changed": []}`, out.String())
+}
+
+const grantDriftState = `{
+  "version": 4,
+  "resources": [
+    {
+      "mode": "managed",
+      "type": "twingate_resource",
+      "name": "db",
+      "instances": [{"attributes": {
+        "id": "resource-1", "name": "DB", "address": "db.internal", "remote_network_id": "network-1", "is_authoritative": true,
+        "protocols": [{"allow_icmp": false, "tcp": [{"policy": "RESTRICTED", "ports": ["22"], "services": ["postgres"]}], "udp": [{"policy": "DENY_ALL", "ports": [], "services": []}]}],
+        "access": [{
+          "group_ids": ["group-1"], "service_account_ids": [],
+          "group_grant": [{"group_id": "group-2", "expires_at": "2999-01-01T00:00:00Z"}, {"group_id": "group-3", "expires_at": "2000-01-01T00:00:00Z"}],
+          "service_account_grant": [{"service_account_id": "account-1", "expires_at": "2999-01-01T00:00:00Z"}]
+        }]
+      }}]
+    }
+  ]
+}`
+
+func TestDetectDriftWithGrantsAndServices(t *testing.T) {
+	objects, err := readState(strings.NewReader(grantDriftState))
+	assert.NoError(t, err)
+
+	live := &model.Resource{
+		ID:              "resource-1",
+		Name:            "DB",
+		Address:         "db.internal",
+		RemoteNetworkID: "network-1",
+		Protocols: &model.Protocols{
+			TCP: model.NewProtocol(model.PolicyRestricted, []*model.PortRange{{Start: 22, End: 22}, {Start: 5432, End: 5432}}),
+			UDP: model.NewProtocol(model.PolicyDenyAll, nil),
+		},
+		Groups:          []string{"group-1", "group-2"},
+		ServiceAccounts: []string{"account-1"},
+	}
+
+	report := detectDrift(objects, liveObjects(&tenant{Resources: []*model.Resource{live}}))
+	assert.False(t, report.hasDrift())
+
+	// the expired grant is still bound
+	live.Groups = append(live.Groups, "group-3")
+	live.Protocols.TCP = model.NewProtocol(model.PolicyRestricted, []*model.PortRange{{Start: 22, End: 22}})
+
+	report = detectDrift(objects, liveObjects(&tenant{Resources: []*model.Resource{live}}))
+
+	var out bytes.Buffer
+
+	assert.NoError(t, writeDriftText(&out, report))
+	assert.Equal(t, `~ twingate_resource.db (resource-1)
+    group_ids: "group-1, group-2" -> "group-1, group-2, group-3"
+    protocols: "tcp=22, 5432 udp=none icmp=false" -> "tcp=22 udp=none icmp=false"
+`, out.String())
+}
@@ -0,0 +1,313 @@
+package cmd
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/Twingate/terraform-provider-twingate/twingate/internal/attr"
+	"github.com/Twingate/terraform-provider-twingate/twingate/internal/model"
+	"github.com/Twingate/terraform-provider-twingate/twingate/internal/provider/resource"
+	"github.com/hashicorp/hcl/v2"
+	"github.com/hashicorp/hcl/v2/hclwrite"
+	"github.com/zclconf/go-cty/cty"
+)
+
+const (
+	exportHeader   = "# Generated by `terraform-provider-twingate export`.\n\n"
+	exportFileMode = 0o600
+	exportDirMode  = 0o700
+)
+
+func exportCommand() *command {
+	return &command{
+		name:        "export",
+		description: "write .tf files with resources and import blocks for all objects of the network",
+		run:         runExport,
+	}
+}
+
+func runExport(ctx context.Context, env *environment, args []string) error {
+	flags := newFlagSet("export", env)
+
+	var conn clientFlags
+	conn.register(flags, "")
+
+	outDir := flags.String("out", ".", "directory to write .tf files to")
+
+	if err := flags.Parse(args); err != nil {
+		return err //nolint:wrapcheck
+	}
+
+	c, err := conn.newClient(env.version)
+	if err != nil {
+		return err
+	}
+
+	network, err := loadTenant(ctx, c)
+	if err != nil {
+		return err
+	}
+
+	if err := os.MkdirAll(*outDir, exportDirMode); err != nil {
+		return fmt.Errorf("failed to create output directory: %w", err)
+	}
+
+	for _, file := range renderExport(network) {
+		path := filepath.Join(*outDir, file.name)
+		if err := os.WriteFile(path, file.content, exportFileMode); err != nil {
+			return fmt.Errorf("failed to write %s: %w", path, err)
+		}
+
+		fmt.Fprintf(env.stdout, "wrote %s\n", path)
+	}
+
+	return nil
+}
+
+type exportFile struct {
+	name    string
+	content []byte
+}
+
+// exporter - renders resource blocks, referencing already rendered objects by their Terraform address.
+type exporter struct {
+	addresses  map[string]hcl.Traversal
+	localNames map[string]map[string]bool
+	imports    *hclwrite.File
+}
+
+func renderExport(network *tenant) []exportFile {
+	exp := &exporter{
+		addresses:  make(map[string]hcl.Traversal),
+		localNames: make(map[string]map[string]bool),
+		imports:    hclwrite.NewEmptyFile(),
+	}
+
+	// order matters: objects can reference only the ones rendered before them
+	files := []exportFile{
+		renderFile("remote_networks.tf", network.RemoteNetworks, exp.remoteNetwork),
+		renderFile("service_accounts.tf", network.ServiceAccounts, exp.serviceAccount),
+		renderFile("users.tf", network.Users, exp.user),
+		renderFile("groups.tf", network.Groups, exp.group),
+		renderFile("connectors.tf", network.Connectors, exp.connector),
+		renderFile("resources.tf", network.Resources, exp.resource),
+	}
+
+	return append(files, exportFile{name: "imports.tf", content: withHeader(exp.imports)})
+}
+
+func renderFile[T any](name string, items []T, renderItem func(body *hclwrite.Body, item T)) exportFile {
+	file := hclwrite.NewEmptyFile()
+
+	for _, item := range items {
+		renderItem(file.Body(), item)
+	}
+
+	return exportFile{name: name, content: withHeader(file)}
+}
+
+func withHeader(file *hclwrite.File) []byte {
+	return append([]byte(exportHeader), bytes.TrimRight(file.Bytes(), "\n")...)
+}
+
+// declare - appends the resource block and the matching import block, and remembers the address of the object.
+func (e *exporter) declare(body *hclwrite.Body, resourceType, name, id string) *hclwrite.Body {
+	localName := e.localName(resourceType, name)
+	address := hcl.Traversal{hcl.TraverseRoot{Name: resourceType}, hcl.TraverseAttr{Name: localName}}
+	e.addresses[id] = address
+
+	block := body.AppendNewBlock("resource", []string{resourceType, localName})
+	body.AppendNewline()
+
+	importBody := e.imports.Body().AppendNewBlock("import", nil).Body()
+	importBody.SetAttributeTraversal("to", address)
+	importBody.SetAttributeValue("id", cty.StringVal(id))
+	e.imports.Body().AppendNewline()
+
+	return block.Body()
+}
+
+// localName - unique within the resource type, valid Terraform identifier derived from the object name.
+func (e *exporter) localName(resourceType, name string) string {
+	used, ok := e.localNames[resourceType]
+	if !ok {
+		used = make(map[string]bool)
+		e.localNames[resourceType] = used
+	}
+
+	base := identifier(name)
+	localName := base
+
+	for n := 2; used[localName]; n++ {
+		localName = base + "_" + strconv.Itoa(n)
+	}
+
+	used[localName] = true
+
+	return localName
+}
+
+func identifier(name string) string {
+	var builder strings.Builder
+
+	for _, char := range strings.ToLower(name) {
+		switch {
+		case char >= 'a' && char <= 'z', char >= '0' && char <= '9', char == '-':
+			builder.WriteRune(char)
+		default:
+			builder.WriteRune('_')
+		}
+	}
+
+	result := strings.Trim(builder.String(), "_-")
+	for strings.Contains(result, "__") {
+		result = strings.ReplaceAll(result, "__", "_")
+	}
+
+	if result == "" {
+		return "unnamed"
+	}
+
+	if result[0] >= '0' && result[0] <= '9' {
+		return "_" + result
+	}
+
+	return result
+}
+
+// reference - to the ID of an exported object, or the ID itself for objects which are not exported.
+func (e *exporter) reference(id string) hclwrite.Tokens {
+	if address, ok := e.addresses[id]; ok {
+		return hclwrite.TokensForTraversal(append(address, hcl.TraverseAttr{Name: attr.ID}))
+	}
+
+	return hclwrite.TokensForValue(cty.StringVal(id))
+}
+
+func (e *exporter) references(ids []string) hclwrite.Tokens {
+	sorted := append([]string(nil), ids...)
+	sort.Strings(sorted)
+
+	elems := make([]hclwrite.Tokens, 0, len(sorted))
+	for _, id := range sorted {
+		elems = append(elems, e.reference(id))
+	}
+
+	return hclwrite.TokensForTuple(elems)
+}
+
+func (e *exporter) remoteNetwork(body *hclwrite.Body, network *model.RemoteNetwork) {
+	block := e.declare(body, resource.TwingateRemoteNetwork, network.Name, network.ID)
+	block.SetAttributeValue(attr.Name, cty.StringVal(network.Name))
+	block.SetAttributeValue(attr.Location, cty.StringVal(network.Location))
+}
+
+func (e *exporter) serviceAccount(body *hclwrite.Body, account *model.ServiceAccount) {
+	block := e.declare(body, resource.TwingateServiceAccount, account.Name, account.ID)
+	block.SetAttributeValue(attr.Name, cty.StringVal(account.Name))
+}
+
+func (e *exporter) user(body *hclwrite.Body, user *model.User) {
+	// synced users are managed by the identity provider
+	if user.Type != model.UserTypeManual {
+		return
+	}
+
+	block := e.declare(body, resource.TwingateUser, user.Email, user.ID)
+	block.SetAttributeValue(attr.Email, cty.StringVal(user.Email))
+	setOptionalString(block, attr.FirstName, user.FirstName)
+	setOptionalString(block, attr.LastName, user.LastName)
+	setOptionalString(block, attr.Role, user.Role)
+}
+
+func (e *exporter) group(body *hclwrite.Body, group *model.Group) {
+	// only manual groups can be managed by Terraform
+	if group.Type != model.GroupTypeManual {
+		return
+	}
+
+	block := e.declare(body, resource.TwingateGroup, group.Name, group.ID)
+	block.SetAttributeValue(attr.Name, cty.StringVal(group.Name))
+
+	if len(group.Users) > 0 {
+		block.SetAttributeRaw(attr.UserIDs, e.references(group.Users))
+	}
+}
+
+func (e *exporter) connector(body *hclwrite.Body, connector *model.Connector) {
+	block := e.declare(body, resource.TwingateConnector, connector.Name, connector.ID)
+	block.SetAttributeValue(attr.Name, cty.StringVal(connector.Name))
+	block.SetAttributeRaw(attr.RemoteNetworkID, e.reference(connector.NetworkID))
+
+	if connector.StatusUpdatesEnabled != nil {
+		block.SetAttributeValue(attr.StatusUpdatesEnabled, cty.BoolVal(*connector.StatusUpdatesEnabled))
+	}
+}
+
+func (e *exporter) resource(body *hclwrite.Body, res *model.Resource) {
+	block := e.declare(body, resource.TwingateResource, res.Name, res.ID)
+	block.SetAttributeValue(attr.Name, cty.StringVal(res.Name))
+	block.SetAttributeValue(attr.Address, cty.StringVal(res.Address))
+	block.SetAttributeRaw(attr.RemoteNetworkID, e.reference(res.RemoteNetworkID))
+
+	if res.Alias != nil {
+		setOptionalString(block, attr.Alias, *res.Alias)
+	}
+
+	if res.IsVisible != nil {
+		block.SetAttributeValue(attr.IsVisible, cty.BoolVal(*res.IsVisible))
+	}
+
+	if res.IsBrowserShortcutEnabled != nil {
+		block.SetAttributeValue(attr.IsBrowserShortcutEnabled, cty.BoolVal(*res.IsBrowserShortcutEnabled))
+	}
+
+	if res.Protocols != nil && !res.Protocols.Equal(model.DefaultProtocols()) {
+		protocols := block.AppendNewBlock(attr.Protocols, nil).Body()
+		protocols.SetAttributeValue(attr.AllowIcmp, cty.BoolVal(res.Protocols.AllowIcmp))
+		renderProtocol(protocols, attr.TCP, res.Protocols.TCP)
+		renderProtocol(protocols, attr.UDP, res.Protocols.UDP)
+	}
+
+	if len(res.Groups) > 0 || len(res.ServiceAccounts) > 0 {
+		access := block.AppendNewBlock(attr.Access, nil).Body()
+
+		if len(res.Groups) > 0 {
+			access.SetAttributeRaw(attr.GroupIDs, e.references(res.Groups))
+		}
+
+		if len(res.ServiceAccounts) > 0 {
+			access.SetAttributeRaw(attr.ServiceAccountIDs, e.references(res.ServiceAccounts))
+		}
+	}
+}
+
+func renderProtocol(body *hclwrite.Body, transport string, protocol *model.Protocol) {
+	if protocol == nil {
+		protocol = model.DefaultProtocol()
+	}
+
+	block := body.AppendNewBlock(transport, nil).Body()
+	block.SetAttributeValue(attr.Policy, cty.StringVal(protocol.EffectivePolicy()))
+
+	if ports := protocol.NormalizedPorts(); len(ports) > 0 && protocol.EffectivePolicy() == model.PolicyRestricted {
+		values := make([]cty.Value, 0, len(ports))
+		for _, port := range ports {
+			values = append(values, cty.StringVal(port.String()))
+		}
+
+		block.SetAttributeValue(attr.Ports, cty.ListVal(values))
+	}
+}
+
+func setOptionalString(body *hclwrite.Body, name, value string) {
+	if value != "" {
+		body.SetAttributeValue(name, cty.StringVal(value))
+	}
+}
@@ -0,0 +1,82 @@
+package cmd
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/Twingate/terraform-provider-twingate/twingate/internal/model"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestIdentifier(t *testing.T) {
+	cases := []struct {
+		name     string
+		expected string
+	}{
+		{name: "Office", expected: "office"},
+		{name: "My  Network!", expected: "my_network"},
+		{name: "user@example.com", expected: "user_example_com"},
+		{name: "10.0.0.1", expected: "_10_0_0_1"},
+		{name: "prod-db", expected: "prod-db"},
+		{name: "???", expected: "unnamed"},
+		{name: "", expected: "unnamed"},
+	}
+
+	for n, c := range cases {
+		t.Run(fmt.Sprintf("case_%d", n), func(t *testing.T) {
+			assert.Equal(t, c.expected, identifier(c.name))
+		})
+	}
+}
+
+func TestRenderExport(t *testing.T) {
+	network := &tenant{
+		RemoteNetworks: []*model.RemoteNetwork{
+			{ID: "network-1", Name: "Office", Location: model.LocationOther},
+			{ID: "network-2", Name: "office", Location: model.LocationAWS},
+		},
+		Users: []*model.User{
+			{ID: "user-1", Email: "admin@example.com", Role: model.UserRoleAdmin, Type: model.UserTypeManual},
+			{ID: "user-2", Email: "synced@example.com", Type: model.UserTypeSynced},
+		},
+		Groups: []*model.Group{
+			{ID: "group-1", Name: "Engineering", Type: model.GroupTypeManual, Users: []string{"user-2", "user-1"}},
+			{ID: "group-2", Name: "Everyone", Type: model.GroupTypeSystem},
+		},
+		Resources: []*model.Resource{
+			{
+				ID:              "resource-1",
+				Name:            "DB",
+				Address:         "db.internal",
+				RemoteNetworkID: "network-1",
+				Groups:          []string{"group-1", "group-2"},
+			},
+		},
+	}
+
+	files := renderExport(network)
+
+	contents := make(map[string]string)
+	for _, file := range files {
+		assert.True(t, strings.HasPrefix(string(file.content), exportHeader))
+		contents[file.name] = string(file.content)
+	}
+
+	assert.Contains(t, contents["remote_networks.tf"], `resource "twingate_remote_network" "office" {`)
+	assert.Contains(t, contents["remote_networks.tf"], `resource "twingate_remote_network" "office_2" {`)
+
+	assert.Contains(t, contents["users.tf"], `email = "admin@example.com"`)
+	assert.NotContains(t, contents["users.tf"], "synced@example.com")
+
+	assert.Contains(t, contents["groups.tf"], `user_ids = [twingate_user.admin_example_com.id, "user-2"]`)
+	assert.NotContains(t, contents["groups.tf"], "Everyone")
+
+	assert.Contains(t, contents["resources.tf"], `remote_network_id = twingate_remote_network.office.id`)
+	assert.Contains(t, contents["resources.tf"], `group_ids = [twingate_group.engineering.id, "group-2"]`)
+	assert.NotContains(t, contents["resources.tf"], "protocols")
+
+	assert.Contains(t, contents["imports.tf"], "to = twingate_remote_network.office_2\n  id = \"network-2\"")
+	assert.Contains(t, contents["imports.tf"], "to = twingate_resource.db\n  id = \"resource-1\"")
+	assert.NotContains(t, contents["imports.tf"], "user-2")
+}
@@ -0,0 +1,413 @@
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"sort"
+	"strings"
+
+	"github.com/Twingate/terraform-provider-twingate/twingate/internal/model"
+	"github.com/Twingate/terraform-provider-twingate/twingate/internal/utils"
+)
+
+// replicateClient - operations used by replicate on the target network, implemented by sdkClient.
+type replicateClient interface {
+	CreateRemoteNetwork(ctx context.Context, req *model.RemoteNetwork) (*model.RemoteNetwork, error)
+	UpdateRemoteNetwork(ctx context.Context, req *model.RemoteNetwork) (*model.RemoteNetwork, error)
+	CreateGroup(ctx context.Context, input *model.Group) (*model.Group, error)
+	CreateResource(ctx context.Context, input *model.Resource) (*model.Resource, error)
+	UpdateResource(ctx context.Context, input *model.Resource) (*model.Resource, error)
+	DeleteResourceGroups(ctx context.Context, resourceID string, deleteGroupIDs []string) error
+}
+
+func replicateCommand() *command {
+	return &command{
+		name:        "replicate",
+		description: "mirror remote networks, resources and group access of the source network on the target network",
+		run:         runReplicate,
+	}
+}
+
+func runReplicate(ctx context.Context, env *environment, args []string) error {
+	flags := newFlagSet("replicate", env)
+
+	var source, target clientFlags
+
+	source.register(flags, "source-")
+	target.register(flags, "target-")
+
+	dryRun := flags.Bool("dry-run", false, "only print changes which would be made on the target network")
+	prune := flags.Bool("prune", false, "also remove group access and aliases of resources which the source network doesn't have")
+
+	if err := flags.Parse(args); err != nil {
+		return err //nolint:wrapcheck
+	}
+
+	sourceClient, err := source.newClient(env.version)
+	if err != nil {
+		return fmt.Errorf("source: %w", err)
+	}
+
+	targetClient, err := target.newClient(env.version)
+	if err != nil {
+		return fmt.Errorf("target: %w", err)
+	}
+
+	sourceNetwork, err := loadTenant(ctx, sourceClient)
+	if err != nil {
+		return fmt.Errorf("source: %w", err)
+	}
+
+	targetNetwork, err := loadTenant(ctx, targetClient)
+	if err != nil {
+		return fmt.Errorf("target: %w", err)
+	}
+
+	rep := newReplicator(&sdkClient{client: targetClient}, sourceNetwork, targetNetwork, env.stdout, *dryRun, *prune)
+
+	return rep.replicate(ctx)
+}
+
+// replicator - applies the difference between the source and the target network to the target network.
+// Objects are matched by name, objects existing only in the target network are left untouched,
+// and group membership is never changed. Missing group access to resources is added, access granted only
+// in the target network is kept unless prune is set, and so are aliases of resources without an alias in the source.
+type replicator struct {
+	client replicateClient
+	source *tenant
+	target *tenant
+	out    io.Writer
+	dryRun bool
+	prune  bool
+
+	remoteNetworkIDs map[string]string
+	groupIDs         map[string]string
+	created          int
+	updated          int
+	warnings         int
+}
+
+func newReplicator(client replicateClient, source, target *tenant, out io.Writer, dryRun, prune bool) *replicator {
+	return &replicator{
+		client:           client,
+		source:           source,
+		target:           target,
+		out:              out,
+		dryRun:           dryRun,
+		prune:            prune,
+		remoteNetworkIDs: make(map[string]string),
+		groupIDs:         make(map[string]string),
+	}
+}
+
+func (r *replicator) replicate(ctx context.Context) error {
+	if err := r.replicateRemoteNetworks(ctx); err != nil {
+		return err
+	}
+
+	if err := r.replicateGroups(ctx); err != nil {
+		return err
+	}
+
+	if err := r.replicateResources(ctx); err != nil {
+		return err
+	}
+
+	if r.dryRun {
+		fmt.Fprintf(r.out, "\nReplication plan: %d to create, %d to update, %d warnings.\n", r.created, r.updated, r.warnings)
+	} else {
+		fmt.Fprintf(r.out, "\nReplication complete: %d created, %d updated, %d warnings.\n", r.created, r.updated, r.warnings)
+	}
+
+	return nil
+}
+
+func (r *replicator) replicateRemoteNetworks(ctx context.Context) error {
+	existing := indexByName(r.target.RemoteNetworks)
+
+	for _, item := range r.source.RemoteNetworks {
+		live, ok := existing[item.Name]
+		if !ok {
+			fmt.Fprintf(r.out, "+ remote_network %q\n", item.Name)
+			r.created++
+
+			id, err := r.apply(item.ID, func() (string, error) {
+				created, err := r.client.CreateRemoteNetwork(ctx, &model.RemoteNetwork{Name: item.Name, Location: item.Location})
+				if err != nil {
+					return "", fmt.Errorf("failed to create remote network %q: %w", item.Name, err)
+				}
+
+				return created.ID, nil
+			})
+			if err != nil {
+				return err
+			}
+
+			r.remoteNetworkIDs[item.ID] = id
+
+			continue
+		}
+
+		r.remoteNetworkIDs[item.ID] = live.ID
+
+		if live.Location == item.Location {
+			continue
+		}
+
+		fmt.Fprintf(r.out, "~ remote_network %q\n    location: %s -> %s\n", item.Name, live.Location, item.Location)
+		r.updated++
+
+		_, err := r.apply(live.ID, func() (string, error) {
+			if _, err := r.client.UpdateRemoteNetwork(ctx, &model.RemoteNetwork{ID: live.ID, Name: live.Name, Location: item.Location}); err != nil {
+				return "", fmt.Errorf("failed to update remote network %q: %w", item.Name, err)
+			}
+
+			return live.ID, nil
+		})
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// replicateGroups - creates missing manual groups without users, users differ between networks.
+func (r *replicator) replicateGroups(ctx context.Context) error {
+	existing := indexByName(r.target.Groups)
+
+	for _, item := range r.source.Groups {
+		if live, ok := existing[item.Name]; ok {
+			r.groupIDs[item.ID] = live.ID
+
+			continue
+		}
+
+		if item.Type != model.GroupTypeManual {
+			fmt.Fprintf(r.out, "! group %q is a %s group missing in the target network, access through it is skipped\n", item.Name, item.Type)
+			r.warnings++
+
+			continue
+		}
+
+		fmt.Fprintf(r.out, "+ group %q (without users)\n", item.Name)
+		r.created++
+
+		id, err := r.apply(item.ID, func() (string, error) {
+			created, err := r.client.CreateGroup(ctx, &model.Group{Name: item.Name})
+			if err != nil {
+				return "", fmt.Errorf("failed to create group %q: %w", item.Name, err)
+			}
+
+			return created.ID, nil
+		})
+		if err != nil {
+			return err
+		}
+
+		r.groupIDs[item.ID] = id
+	}
+
+	return nil
+}
+
+func (r *replicator) replicateResources(ctx context.Context) error {
+	type resourceKey struct{ remoteNetworkID, name string }
+
+	existing := make(map[resourceKey]*model.Resource, len(r.target.Resources))
+	for _, res := range r.target.Resources {
+		existing[resourceKey{res.RemoteNetworkID, res.Name}] = res
+	}
+
+	for _, item := range r.source.Resources {
+		desired := &model.Resource{
+			Name:                     item.Name,
+			Address:                  item.Address,
+			RemoteNetworkID:          r.remoteNetworkIDs[item.RemoteNetworkID],
+			Protocols:                item.Protocols,
+			Alias:                    item.Alias,
+			IsVisible:                item.IsVisible,
+			IsBrowserShortcutEnabled: item.IsBrowserShortcutEnabled,
+			Groups:                   mapIDs(item.Groups, r.groupIDs),
+		}
+
+		live, ok := existing[resourceKey{desired.RemoteNetworkID, item.Name}]
+		if !ok {
+			if err := r.createResource(ctx, desired); err != nil {
+				return err
+			}
+
+			continue
+		}
+
+		if err := r.updateResource(ctx, live, desired); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (r *replicator) createResource(ctx context.Context, desired *model.Resource) error {
+	fmt.Fprintf(r.out, "+ resource %q\n", desired.Name)
+	r.created++
+
+	_, err := r.apply("", func() (string, error) {
+		if _, err := r.client.CreateResource(ctx, desired); err != nil {
+			return "", fmt.Errorf("failed to create resource %q: %w", desired.Name, err)
+		}
+
+		return "", nil
+	})
+
+	return err
+}
+
+func (r *replicator) updateResource(ctx context.Context, live, desired *model.Resource) error {
+	// the update clears the alias when it isn't set
+	if desired.Alias == nil && !r.prune {
+		desired.Alias = live.Alias
+	}
+
+	changes := r.resourceChanges(live, desired)
+	if len(changes) == 0 {
+		return nil
+	}
+
+	fmt.Fprintf(r.out, "~ resource %q\n", desired.Name)
+
+	for _, change := range changes {
+		fmt.Fprintf(r.out, "    %s\n", change)
+	}
+
+	r.updated++
+
+	desired.ID = live.ID
+
+	_, err := r.apply(live.ID, func() (string, error) {
+		if removed := r.removedGroups(live, desired); len(removed) > 0 {
+			if err := r.client.DeleteResourceGroups(ctx, live.ID, removed); err != nil {
+				return "", fmt.Errorf("failed to update resource %q: %w", desired.Name, err)
+			}
+		}
+
+		if _, err := r.client.UpdateResource(ctx, desired); err != nil {
+			return "", fmt.Errorf("failed to update resource %q: %w", desired.Name, err)
+		}
+
+		return live.ID, nil
+	})
+
+	return err
+}
+
+// resourceChanges - human readable differences between the target resource and the desired state.
+func (r *replicator) resourceChanges(live, desired *model.Resource) []string {
+	var changes []string
+
+	if model.CanonicalAddress(live.Address) != model.CanonicalAddress(desired.Address) {
+		changes = append(changes, fmt.Sprintf("address: %s -> %s", live.Address, desired.Address))
+	}
+
+	if !protocolsOrDefault(live.Protocols).Equal(protocolsOrDefault(desired.Protocols)) {
+		changes = append(changes, "protocols: "+formatProtocols(live.Protocols)+" -> "+formatProtocols(desired.Protocols))
+	}
+
+	if stringValue(live.Alias) != stringValue(desired.Alias) {
+		changes = append(changes, fmt.Sprintf("alias: %q -> %q", stringValue(live.Alias), stringValue(desired.Alias)))
+	}
+
+	if desired.IsVisible != nil && (live.IsVisible == nil || *live.IsVisible != *desired.IsVisible) {
+		changes = append(changes, fmt.Sprintf("is_visible: -> %t", *desired.IsVisible))
+	}
+
+	if desired.IsBrowserShortcutEnabled != nil &&
+		(live.IsBrowserShortcutEnabled == nil || *live.IsBrowserShortcutEnabled != *desired.IsBrowserShortcutEnabled) {
+		changes = append(changes, fmt.Sprintf("is_browser_shortcut_enabled: -> %t", *desired.IsBrowserShortcutEnabled))
+	}
+
+	added := utils.Filter(desired.Groups, func(id string) bool { return !utils.Contains(live.Groups, id) })
+	removed := r.removedGroups(live, desired)
+
+	if len(added) > 0 || len(removed) > 0 {
+		changes = append(changes, fmt.Sprintf("groups: +[%s] -[%s]", r.groupNames(added), r.groupNames(removed)))
+	}
+
+	return changes
+}
+
+// removedGroups - groups with access to the target resource only, these are removed when pruning.
+func (r *replicator) removedGroups(live, desired *model.Resource) []string {
+	if !r.prune {
+		return nil
+	}
+
+	return utils.Filter(live.Groups, func(id string) bool { return !utils.Contains(desired.Groups, id) })
+}
+
+// apply - runs the change unless it's a dry run, in which case the given placeholder ID is returned.
+func (r *replicator) apply(placeholderID string, change func() (string, error)) (string, error) {
+	if r.dryRun {
+		return placeholderID, nil
+	}
+
+	return change()
+}
+
+func (r *replicator) groupNames(ids []string) string {
+	names := make([]string, 0, len(ids))
+
+	targetGroups := indexByID(r.target.Groups)
+	sourceGroups := indexByID(r.source.Groups)
+
+	for _, id := range ids {
+		switch {
+		case targetGroups[id] != nil:
+			names = append(names, targetGroups[id].Name)
+		case sourceGroups[id] != nil:
+			names = append(names, sourceGroups[id].Name)
+		default:
+			names = append(names, id)
+		}
+	}
+
+	sort.Strings(names)
+
+	return strings.Join(names, ", ")
+}
+
+// mapIDs - translates IDs using the mapping, dropping IDs which can't be translated.
+func mapIDs(ids []string, mapping map[string]string) []string {
+	result := make([]string, 0, len(ids))
+
+	for _, id := range ids {
+		if mapped, ok := mapping[id]; ok {
+			result = append(result, mapped)
+		}
+	}
+
+	return result
+}
+
+func protocolsOrDefault(protocols *model.Protocols) *model.Protocols {
+	if protocols == nil {
+		return model.DefaultProtocols()
+	}
+
+	return protocols
+}
+
+func formatProtocols(protocols *model.Protocols) string {
+	protocols = protocolsOrDefault(protocols)
+
+	return fmt.Sprintf("tcp=%s udp=%s icmp=%t", formatPorts(protocols.TCP), formatPorts(protocols.UDP), protocols.AllowIcmp)
+}
+
+func stringValue(value *string) string {
+	if value == nil {
+		return ""
+	}
+
+	return *value
+}
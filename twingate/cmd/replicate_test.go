@@ -0,0 +1,187 @@
+package cmd
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/Twingate/terraform-provider-twingate/twingate/internal/model"
+	"github.com/stretchr/testify/assert"
+)
+
+// fakeReplicateClient - records changes made on the target network.
+type fakeReplicateClient struct {
+	calls   []string
+	updated []*model.Resource
+}
+
+func (f *fakeReplicateClient) record(format string, args ...interface{}) string {
+	f.calls = append(f.calls, fmt.Sprintf(format, args...))
+
+	return fmt.Sprintf("new-%d", len(f.calls))
+}
+
+func (f *fakeReplicateClient) CreateRemoteNetwork(_ context.Context, req *model.RemoteNetwork) (*model.RemoteNetwork, error) {
+	return &model.RemoteNetwork{ID: f.record("create remote network %s", req.Name)}, nil
+}
+
+func (f *fakeReplicateClient) UpdateRemoteNetwork(_ context.Context, req *model.RemoteNetwork) (*model.RemoteNetwork, error) {
+	f.record("update remote network %s %s", req.ID, req.Location)
+
+	return req, nil
+}
+
+func (f *fakeReplicateClient) CreateGroup(_ context.Context, input *model.Group) (*model.Group, error) {
+	return &model.Group{ID: f.record("create group %s %v", input.Name, input.Users)}, nil
+}
+
+func (f *fakeReplicateClient) CreateResource(_ context.Context, input *model.Resource) (*model.Resource, error) {
+	return &model.Resource{ID: f.record("create resource %s %s %v", input.Name, input.RemoteNetworkID, input.Groups)}, nil
+}
+
+func (f *fakeReplicateClient) UpdateResource(_ context.Context, input *model.Resource) (*model.Resource, error) {
+	f.record("update resource %s %s %v", input.ID, input.Address, input.Groups)
+	f.updated = append(f.updated, input)
+
+	return input, nil
+}
+
+func (f *fakeReplicateClient) DeleteResourceGroups(_ context.Context, resourceID string, deleteGroupIDs []string) error {
+	f.record("delete resource groups %s %v", resourceID, deleteGroupIDs)
+
+	return nil
+}
+
+func newReplicateSource() *tenant {
+	return &tenant{
+		RemoteNetworks: []*model.RemoteNetwork{
+			{ID: "src-network-1", Name: "Office", Location: model.LocationAWS},
+			{ID: "src-network-2", Name: "Lab", Location: model.LocationOther},
+		},
+		Groups: []*model.Group{
+			{ID: "src-group-1", Name: "Engineering", Type: model.GroupTypeManual, Users: []string{"src-user-1"}},
+			{ID: "src-group-2", Name: "Support", Type: model.GroupTypeManual},
+			{ID: "src-group-3", Name: "Okta", Type: model.GroupTypeSynced},
+		},
+		Resources: []*model.Resource{
+			{ID: "src-resource-1", Name: "DB", Address: "db.internal", RemoteNetworkID: "src-network-1", Groups: []string{"src-group-1", "src-group-3"}},
+			{ID: "src-resource-2", Name: "Web", Address: "web.internal", RemoteNetworkID: "src-network-1", Groups: []string{"src-group-1"}},
+			{ID: "src-resource-3", Name: "Printer", Address: "printer.lab", RemoteNetworkID: "src-network-2", Groups: []string{"src-group-2"}},
+		},
+	}
+}
+
+func newReplicateTarget() *tenant {
+	return &tenant{
+		RemoteNetworks: []*model.RemoteNetwork{
+			{ID: "network-1", Name: "Office", Location: model.LocationOther},
+		},
+		Groups: []*model.Group{
+			{ID: "group-1", Name: "Engineering", Type: model.GroupTypeManual, Users: []string{"user-1"}},
+			{ID: "group-4", Name: "Contractors", Type: model.GroupTypeManual},
+		},
+		Resources: []*model.Resource{
+			{ID: "resource-1", Name: "DB", Address: "db.staging", RemoteNetworkID: "network-1", Groups: []string{"group-1", "group-4"}},
+			{ID: "resource-2", Name: "Web", Address: "web.internal", RemoteNetworkID: "network-1", Groups: []string{"group-1"}},
+		},
+	}
+}
+
+func TestReplicateDryRun(t *testing.T) {
+	var (
+		out    bytes.Buffer
+		client fakeReplicateClient
+	)
+
+	assert.NoError(t, newReplicator(&client, newReplicateSource(), newReplicateTarget(), &out, true, true).replicate(context.Background()))
+
+	assert.Empty(t, client.calls)
+	assert.Equal(t, `~ remote_network "Office"
+    location: OTHER -> AWS
++ remote_network "Lab"
++ group "Support" (without users)
+! group "Okta" is a SYNCED group missing in the target network, access through it is skipped
+~ resource "DB"
+    address: db.staging -> db.internal
+    groups: +[] -[Contractors]
++ resource "Printer"
+
+Replication plan: 3 to create, 2 to update, 1 warnings.
+`, out.String())
+}
+
+func TestReplicateApply(t *testing.T) {
+	var (
+		out    bytes.Buffer
+		client fakeReplicateClient
+	)
+
+	assert.NoError(t, newReplicator(&client, newReplicateSource(), newReplicateTarget(), &out, false, true).replicate(context.Background()))
+
+	assert.Equal(t, []string{
+		"update remote network network-1 AWS",
+		"create remote network Lab",
+		"create group Support []",
+		"delete resource groups resource-1 [group-4]",
+		"update resource resource-1 db.internal [group-1]",
+		"create resource Printer new-2 [new-3]",
+	}, client.calls)
+	assert.Contains(t, out.String(), "Replication complete: 3 created, 2 updated, 1 warnings.")
+}
+
+func TestReplicateKeepsTargetOnlyGroupAccess(t *testing.T) {
+	var (
+		out    bytes.Buffer
+		client fakeReplicateClient
+	)
+
+	assert.NoError(t, newReplicator(&client, newReplicateSource(), newReplicateTarget(), &out, false, false).replicate(context.Background()))
+
+	assert.Equal(t, []string{
+		"update remote network network-1 AWS",
+		"create remote network Lab",
+		"create group Support []",
+		"update resource resource-1 db.internal [group-1]",
+		"create resource Printer new-2 [new-3]",
+	}, client.calls)
+	assert.NotContains(t, out.String(), "Contractors")
+}
+
+func TestReplicateKeepsTargetOnlyAlias(t *testing.T) {
+	alias := "db.corp"
+
+	cases := []struct {
+		prune          bool
+		expectedOutput string
+		expectedAlias  *string
+	}{
+		{
+			prune:          false,
+			expectedOutput: "~ resource \"DB\"\n    address: db.staging -> db.internal\n+ resource",
+			expectedAlias:  &alias,
+		},
+		{
+			prune:          true,
+			expectedOutput: "~ resource \"DB\"\n    address: db.staging -> db.internal\n    alias: \"db.corp\" -> \"\"\n    groups: +[] -[Contractors]\n",
+		},
+	}
+
+	for n, c := range cases {
+		t.Run(fmt.Sprintf("case_%d", n), func(t *testing.T) {
+			var (
+				out    bytes.Buffer
+				client fakeReplicateClient
+			)
+
+			target := newReplicateTarget()
+			target.Resources[0].Alias = &alias
+
+			assert.NoError(t, newReplicator(&client, newReplicateSource(), target, &out, false, c.prune).replicate(context.Background()))
+
+			assert.Contains(t, out.String(), c.expectedOutput)
+			assert.Len(t, client.updated, 1)
+			assert.Equal(t, c.expectedAlias, client.updated[0].Alias)
+		})
+	}
+}
@@ -0,0 +1,385 @@
+package cmd
+
+import (
+	"context"
+	"encoding/csv"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/Twingate/terraform-provider-twingate/twingate/internal/model"
+)
+
+const (
+	formatCSV      = "csv"
+	formatJSON     = "json"
+	formatMarkdown = "markdown"
+
+	principalUser           = "user"
+	principalServiceAccount = "service_account"
+
+	reportFileMode = 0o600
+)
+
+var (
+	ErrUnknownReport         = errors.New("unknown report")
+	ErrUnknownFormat         = errors.New("unknown format")
+	ErrRemoteNetworkNotFound = errors.New("remote network not found")
+	ErrGroupNotFound         = errors.New("group not found")
+)
+
+func reportCommand() *command {
+	return &command{
+		name:        "report",
+		description: "generate reports about the network, available reports: access",
+		run:         runReport,
+	}
+}
+
+func reports() map[string]func(ctx context.Context, env *environment, args []string) error {
+	return map[string]func(ctx context.Context, env *environment, args []string) error{
+		"access": runAccessReport,
+	}
+}
+
+func runReport(ctx context.Context, env *environment, args []string) error {
+	if len(args) == 0 {
+		return fmt.Errorf("%w: report name is required", ErrUnknownReport)
+	}
+
+	run, ok := reports()[args[0]]
+	if !ok {
+		return fmt.Errorf("%w: %s", ErrUnknownReport, args[0])
+	}
+
+	return run(ctx, env, args[1:])
+}
+
+// accessEntry - a principal having access to a resource, either directly or through one or more groups.
+type accessEntry struct {
+	PrincipalType string   `json:"principal_type"`
+	PrincipalID   string   `json:"principal_id"`
+	Principal     string   `json:"principal"`
+	ResourceID    string   `json:"resource_id"`
+	Resource      string   `json:"resource"`
+	Address       string   `json:"address"`
+	RemoteNetwork string   `json:"remote_network"`
+	Groups        []string `json:"groups"`
+	TCP           string   `json:"tcp"`
+	UDP           string   `json:"udp"`
+	ICMP          bool     `json:"icmp"`
+}
+
+// accessFilter - remote network and group are matched by either name or ID, empty values match everything.
+type accessFilter struct {
+	remoteNetwork string
+	group         string
+}
+
+func runAccessReport(ctx context.Context, env *environment, args []string) error {
+	flags := newFlagSet("report access", env)
+
+	var (
+		conn   clientFlags
+		filter accessFilter
+	)
+
+	conn.register(flags, "")
+
+	format := flags.String("format", formatCSV, "output format: csv, json or markdown")
+	out := flags.String("out", "", "file to write the report to, defaults to stdout")
+
+	flags.StringVar(&filter.remoteNetwork, "remote-network", "", "only include resources of the remote network with the given name or ID")
+	flags.StringVar(&filter.group, "group", "", "only include access granted through the group with the given name or ID")
+
+	if err := flags.Parse(args); err != nil {
+		return err //nolint:wrapcheck
+	}
+
+	write, ok := accessWriters()[*format]
+	if !ok {
+		return fmt.Errorf("%w: %s", ErrUnknownFormat, *format)
+	}
+
+	c, err := conn.newClient(env.version)
+	if err != nil {
+		return err
+	}
+
+	network, err := loadTenant(ctx, c)
+	if err != nil {
+		return err
+	}
+
+	entries, err := buildAccessMatrix(network, filter)
+	if err != nil {
+		return err
+	}
+
+	if *out == "" {
+		return write(env.stdout, entries)
+	}
+
+	file, err := os.OpenFile(*out, os.O_CREATE|os.O_WRONLY|os.O_TRUNC, reportFileMode)
+	if err != nil {
+		return fmt.Errorf("failed to create %s: %w", *out, err)
+	}
+
+	if err := write(file, entries); err != nil {
+		_ = file.Close()
+
+		return err
+	}
+
+	return file.Close() //nolint:wrapcheck
+}
+
+func buildAccessMatrix(network *tenant, filter accessFilter) ([]*accessEntry, error) {
+	remoteNetworkID, err := findByNameOrID(network.RemoteNetworks, filter.remoteNetwork, ErrRemoteNetworkNotFound)
+	if err != nil {
+		return nil, err
+	}
+
+	groupID, err := findByNameOrID(network.Groups, filter.group, ErrGroupNotFound)
+	if err != nil {
+		return nil, err
+	}
+
+	groups := indexByID(network.Groups)
+	users := indexByID(network.Users)
+	serviceAccounts := indexByID(network.ServiceAccounts)
+
+	entries := make([]*accessEntry, 0)
+
+	for _, res := range network.Resources {
+		if remoteNetworkID != "" && res.RemoteNetworkID != remoteNetworkID {
+			continue
+		}
+
+		byPrincipal := make(map[string]*accessEntry)
+		newEntry := func(principalType, principalID, principal string) *accessEntry {
+			if entry, ok := byPrincipal[principalID]; ok {
+				return entry
+			}
+
+			entry := newAccessEntry(network, res, principalType, principalID, principal)
+			byPrincipal[principalID] = entry
+			entries = append(entries, entry)
+
+			return entry
+		}
+
+		for _, id := range res.Groups {
+			if groupID != "" && id != groupID {
+				continue
+			}
+
+			group, ok := groups[id]
+			if !ok {
+				continue
+			}
+
+			for _, userID := range group.Users {
+				entry := newEntry(principalUser, userID, userEmail(users, userID))
+				entry.Groups = append(entry.Groups, group.Name)
+			}
+		}
+
+		// service accounts are granted access directly, so they don't pass the group filter
+		if groupID != "" {
+			continue
+		}
+
+		for _, id := range res.ServiceAccounts {
+			name := id
+			if account, ok := serviceAccounts[id]; ok {
+				name = account.Name
+			}
+
+			newEntry(principalServiceAccount, id, name)
+		}
+	}
+
+	sortAccessEntries(entries)
+
+	return entries, nil
+}
+
+func newAccessEntry(network *tenant, res *model.Resource, principalType, principalID, principal string) *accessEntry {
+	protocols := res.Protocols
+	if protocols == nil {
+		protocols = model.DefaultProtocols()
+	}
+
+	return &accessEntry{
+		PrincipalType: principalType,
+		PrincipalID:   principalID,
+		Principal:     principal,
+		ResourceID:    res.ID,
+		Resource:      res.Name,
+		Address:       res.Address,
+		RemoteNetwork: network.remoteNetworkName(res.RemoteNetworkID),
+		Groups:        []string{},
+		TCP:           formatPorts(protocols.TCP),
+		UDP:           formatPorts(protocols.UDP),
+		ICMP:          protocols.AllowIcmp,
+	}
+}
+
+func sortAccessEntries(entries []*accessEntry) {
+	for _, entry := range entries {
+		sort.Strings(entry.Groups)
+	}
+
+	sort.SliceStable(entries, func(i, j int) bool {
+		left, right := entries[i], entries[j]
+
+		switch {
+		case left.PrincipalType != right.PrincipalType:
+			return left.PrincipalType > right.PrincipalType // users first
+		case left.Principal != right.Principal:
+			return left.Principal < right.Principal
+		case left.Resource != right.Resource:
+			return left.Resource < right.Resource
+		default:
+			return left.ResourceID < right.ResourceID
+		}
+	})
+}
+
+// formatPorts - `all`, `none` or comma separated list of port ranges.
+func formatPorts(protocol *model.Protocol) string {
+	if protocol == nil {
+		protocol = model.DefaultProtocol()
+	}
+
+	switch protocol.EffectivePolicy() {
+	case model.PolicyAllowAll:
+		return "all"
+	case model.PolicyDenyAll:
+		return "none"
+	}
+
+	ports := protocol.NormalizedPorts()
+
+	values := make([]string, 0, len(ports))
+	for _, port := range ports {
+		values = append(values, port.String())
+	}
+
+	return strings.Join(values, ", ")
+}
+
+func userEmail(users map[string]*model.User, id string) string {
+	if user, ok := users[id]; ok {
+		return user.Email
+	}
+
+	return id
+}
+
+func indexByID[T named](items []T) map[string]T {
+	result := make(map[string]T, len(items))
+	for _, item := range items {
+		result[item.GetID()] = item
+	}
+
+	return result
+}
+
+// findByNameOrID - returns ID of the matching item, or empty string when the value is empty.
+func findByNameOrID[T named](items []T, value string, errNotFound error) (string, error) {
+	if value == "" {
+		return "", nil
+	}
+
+	for _, item := range items {
+		if item.GetID() == value || item.GetName() == value {
+			return item.GetID(), nil
+		}
+	}
+
+	return "", fmt.Errorf("%w: %s", errNotFound, value)
+}
+
+func accessWriters() map[string]func(out io.Writer, entries []*accessEntry) error {
+	return map[string]func(out io.Writer, entries []*accessEntry) error{
+		formatCSV:      writeAccessCSV,
+		formatJSON:     writeAccessJSON,
+		formatMarkdown: writeAccessMarkdown,
+	}
+}
+
+var accessColumns = []string{ //nolint:gochecknoglobals
+	"Principal Type", "Principal", "Resource", "Address", "Remote Network", "Groups", "TCP", "UDP", "ICMP",
+}
+
+func (e *accessEntry) columns() []string {
+	return []string{
+		e.PrincipalType, e.Principal, e.Resource, e.Address, e.RemoteNetwork,
+		strings.Join(e.Groups, ", "), e.TCP, e.UDP, strconv.FormatBool(e.ICMP),
+	}
+}
+
+func writeAccessCSV(out io.Writer, entries []*accessEntry) error {
+	writer := csv.NewWriter(out)
+
+	if err := writer.Write(accessColumns); err != nil {
+		return fmt.Errorf("failed to write report: %w", err)
+	}
+
+	for _, entry := range entries {
+		if err := writer.Write(entry.columns()); err != nil {
+			return fmt.Errorf("failed to write report: %w", err)
+		}
+	}
+
+	writer.Flush()
+
+	return writer.Error() //nolint:wrapcheck
+}
+
+func writeAccessJSON(out io.Writer, entries []*accessEntry) error {
+	encoder := json.NewEncoder(out)
+	encoder.SetIndent("", "  ")
+
+	return encoder.Encode(entries) //nolint:wrapcheck
+}
+
+func writeAccessMarkdown(out io.Writer, entries []*accessEntry) error {
+	var builder strings.Builder
+
+	writeRow := func(values []string) {
+		builder.WriteString("|")
+
+		for _, value := range values {
+			builder.WriteString(" ")
+			builder.WriteString(strings.ReplaceAll(value, "|", `\|`))
+			builder.WriteString(" |")
+		}
+
+		builder.WriteString("\n")
+	}
+
+	writeRow(accessColumns)
+
+	separator := make([]string, len(accessColumns))
+	for i := range separator {
+		separator[i] = "---"
+	}
+
+	writeRow(separator)
+
+	for _, entry := range entries {
+		writeRow(entry.columns())
+	}
+
+	_, err := io.WriteString(out, builder.String())
+
+	return err //nolint:wrapcheck
+}
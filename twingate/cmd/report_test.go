@@ -0,0 +1,175 @@
+package cmd
+
+import (
+	"bytes"
+	"fmt"
+	"testing"
+
+	"github.com/Twingate/terraform-provider-twingate/twingate/internal/model"
+	"github.com/stretchr/testify/assert"
+)
+
+func newReportTenant() *tenant {
+	return &tenant{
+		RemoteNetworks: []*model.RemoteNetwork{
+			{ID: "network-1", Name: "Office"},
+			{ID: "network-2", Name: "AWS"},
+		},
+		Users: []*model.User{
+			{ID: "user-1", Email: "alice@example.com"},
+			{ID: "user-2", Email: "bob@example.com"},
+		},
+		Groups: []*model.Group{
+			{ID: "group-1", Name: "Engineering", Users: []string{"user-1", "user-2"}},
+			{ID: "group-2", Name: "Admins", Users: []string{"user-1"}},
+		},
+		ServiceAccounts: []*model.ServiceAccount{
+			{ID: "account-1", Name: "CI"},
+		},
+		Resources: []*model.Resource{
+			{
+				ID:              "resource-1",
+				Name:            "DB",
+				Address:         "db.internal",
+				RemoteNetworkID: "network-1",
+				Groups:          []string{"group-1", "group-2"},
+				ServiceAccounts: []string{"account-1"},
+				Protocols: &model.Protocols{
+					AllowIcmp: false,
+					TCP:       &model.Protocol{Policy: model.PolicyRestricted, Ports: []*model.PortRange{{Start: 5432, End: 5432}, {Start: 22, End: 22}}},
+					UDP:       &model.Protocol{Policy: model.PolicyDenyAll},
+				},
+			},
+			{
+				ID:              "resource-2",
+				Name:            "Web",
+				Address:         "web.internal",
+				RemoteNetworkID: "network-2",
+				Groups:          []string{"group-2"},
+			},
+		},
+	}
+}
+
+func TestBuildAccessMatrix(t *testing.T) {
+	cases := []struct {
+		filter   accessFilter
+		expected []string
+		err      error
+	}{
+		{
+			filter: accessFilter{},
+			expected: []string{
+				"user alice@example.com DB [Admins Engineering]",
+				"user alice@example.com Web [Admins]",
+				"user bob@example.com DB [Engineering]",
+				"service_account CI DB []",
+			},
+		},
+		{
+			filter: accessFilter{remoteNetwork: "AWS"},
+			expected: []string{
+				"user alice@example.com Web [Admins]",
+			},
+		},
+		{
+			filter: accessFilter{group: "group-1"},
+			expected: []string{
+				"user alice@example.com DB [Engineering]",
+				"user bob@example.com DB [Engineering]",
+			},
+		},
+		{
+			filter: accessFilter{remoteNetwork: "network-1", group: "Admins"},
+			expected: []string{
+				"user alice@example.com DB [Admins]",
+			},
+		},
+		{
+			filter: accessFilter{remoteNetwork: "unknown"},
+			err:    ErrRemoteNetworkNotFound,
+		},
+		{
+			filter: accessFilter{group: "unknown"},
+			err:    ErrGroupNotFound,
+		},
+	}
+
+	for n, c := range cases {
+		t.Run(fmt.Sprintf("case_%d", n), func(t *testing.T) {
+			entries, err := buildAccessMatrix(newReportTenant(), c.filter)
+
+			if c.err != nil {
+				assert.ErrorIs(t, err, c.err)
+
+				return
+			}
+
+			assert.NoError(t, err)
+
+			actual := make([]string, 0, len(entries))
+			for _, entry := range entries {
+				actual = append(actual, fmt.Sprintf("%s %s %s %v", entry.PrincipalType, entry.Principal, entry.Resource, entry.Groups))
+			}
+
+			assert.Equal(t, c.expected, actual)
+		})
+	}
+}
+
+func TestAccessWriters(t *testing.T) {
+	entries, err := buildAccessMatrix(newReportTenant(), accessFilter{group: "Engineering"})
+	assert.NoError(t, err)
+
+	cases := []struct {
+		format   string
+		expected string
+	}{
+		{
+			format: formatCSV,
+			expected: `Principal Type,Principal,Resource,Address,Remote Network,Groups,TCP,UDP,ICMP
+user,alice@example.com,DB,db.internal,Office,Engineering,"22, 5432",none,false
+user,bob@example.com,DB,db.internal,Office,Engineering,"22, 5432",none,false
+`,
+		},
+		{
+			format: formatMarkdown,
+			expected: `| Principal Type | Principal | Resource | Address | Remote Network | Groups | TCP | UDP | ICMP |
+| --- | --- | --- | --- | --- | --- | --- | --- | --- |
+| user | alice@example.com | DB | db.internal | Office | Engineering | 22, 5432 | none | false |
+| user | bob@example.com | DB | db.internal | Office | Engineering | 22, 5432 | none | false |
+`,
+		},
+	}
+
+	for n, c := range cases {
+		t.Run(fmt.Sprintf("case_%d", n), func(t *testing.T) {
+			var out bytes.Buffer
+
+			assert.NoError(t, accessWriters()[c.format](&out, entries))
+			assert.Equal(t, c.expected, out.String())
+		})
+	}
+}
+
+func TestAccessReportJSON(t *testing.T) {
+	entries, err := buildAccessMatrix(newReportTenant(), accessFilter{remoteNetwork: "AWS"})
+	assert.NoError(t, err)
+
+	var out bytes.Buffer
+
+	assert.NoError(t, writeAccessJSON(&out, entries))
+	assert.JSONEq(t, `[{
+		"principal_type": "user",
+		"principal_id": "user-1",
+		"principal": "alice@example.com",
+		"resource_id": "resource-2",
+		"resource": "Web",
+		"address": "web.internal",
+		"remote_network": "AWS",
+		"groups": ["Admins"],
+		"tcp": "all",
+		"udp": "all",
+		"icmp": true
+	}]`, out.String())
+}
@@ -0,0 +1,427 @@
+package cmd
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+
+	"github.com/Twingate/terraform-provider-twingate/twingate/internal/model"
+)
+
+var ErrSnapshotNotSet = errors.New("snapshot file not set")
+
+// restoreClient - operations used by restore, implemented by sdkClient.
+type restoreClient interface {
+	CreateRemoteNetwork(ctx context.Context, req *model.RemoteNetwork) (*model.RemoteNetwork, error)
+	CreateUser(ctx context.Context, input *model.User) (*model.User, error)
+	CreateServiceAccount(ctx context.Context, serviceAccountName string) (*model.ServiceAccount, error)
+	CreateGroup(ctx context.Context, input *model.Group) (*model.Group, error)
+	CreateConnector(ctx context.Context, input *model.Connector) (*model.Connector, error)
+	CreateResource(ctx context.Context, input *model.Resource) (*model.Resource, error)
+	AddResourceServiceAccountIDs(ctx context.Context, resource *model.Resource) error
+}
+
+func restoreCommand() *command {
+	return &command{
+		name:        "restore",
+		description: "recreate objects of a snapshot which are missing in the network",
+		run:         runRestore,
+	}
+}
+
+func runRestore(ctx context.Context, env *environment, args []string) error {
+	flags := newFlagSet("restore", env)
+
+	var conn clientFlags
+	conn.register(flags, "")
+
+	in := flags.String("in", "", "snapshot file to restore")
+	dryRun := flags.Bool("dry-run", false, "only print objects which would be created")
+
+	if err := flags.Parse(args); err != nil {
+		return err //nolint:wrapcheck
+	}
+
+	if *in == "" {
+		return ErrSnapshotNotSet
+	}
+
+	file, err := os.Open(*in)
+	if err != nil {
+		return fmt.Errorf("failed to open %s: %w", *in, err)
+	}
+
+	snap, err := readSnapshot(file)
+	_ = file.Close()
+
+	if err != nil {
+		return err
+	}
+
+	c, err := conn.newClient(env.version)
+	if err != nil {
+		return err
+	}
+
+	network, err := loadTenant(ctx, c)
+	if err != nil {
+		return err
+	}
+
+	rest := newRestorer(&sdkClient{client: c}, network, env.stdout, *dryRun)
+
+	return rest.restore(ctx, snap)
+}
+
+// restorer - creates snapshot objects missing in the network, matching existing objects by name
+// and translating references from snapshot IDs to IDs of the network.
+type restorer struct {
+	client  restoreClient
+	network *tenant
+	out     io.Writer
+	dryRun  bool
+
+	ids      map[string]string
+	created  int
+	warnings int
+}
+
+func newRestorer(client restoreClient, network *tenant, out io.Writer, dryRun bool) *restorer {
+	return &restorer{
+		client:  client,
+		network: network,
+		out:     out,
+		dryRun:  dryRun,
+		ids:     make(map[string]string),
+	}
+}
+
+func (r *restorer) restore(ctx context.Context, snap *snapshot) error {
+	// order matters: objects are created only after the ones they reference
+	steps := []func(ctx context.Context, snap *snapshot) error{
+		r.restoreRemoteNetworks,
+		r.restoreSecurityPolicies,
+		r.restoreUsers,
+		r.restoreServiceAccounts,
+		r.restoreGroups,
+		r.restoreConnectors,
+		r.restoreResources,
+	}
+
+	for _, step := range steps {
+		if err := step(ctx, snap); err != nil {
+			return err
+		}
+	}
+
+	if r.dryRun {
+		fmt.Fprintf(r.out, "\nRestore plan: %d to create, %d warnings.\n", r.created, r.warnings)
+	} else {
+		fmt.Fprintf(r.out, "\nRestore complete: %d created, %d warnings.\n", r.created, r.warnings)
+	}
+
+	return nil
+}
+
+func (r *restorer) restoreRemoteNetworks(ctx context.Context, snap *snapshot) error {
+	existing := indexByName(r.network.RemoteNetworks)
+
+	for _, item := range snap.RemoteNetworks {
+		if live, ok := existing[item.Name]; ok {
+			r.ids[item.ID] = live.ID
+
+			continue
+		}
+
+		err := r.create(item.ID, "remote_network", item.Name, func() (string, error) {
+			created, err := r.client.CreateRemoteNetwork(ctx, &model.RemoteNetwork{Name: item.Name, Location: item.Location})
+			if err != nil {
+				return "", err
+			}
+
+			return created.ID, nil
+		})
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// restoreSecurityPolicies - security policies can't be created through the API, they're only linked by name.
+func (r *restorer) restoreSecurityPolicies(_ context.Context, snap *snapshot) error {
+	existing := indexByName(r.network.SecurityPolicies)
+
+	for _, item := range snap.SecurityPolicies {
+		if live, ok := existing[item.Name]; ok {
+			r.ids[item.ID] = live.ID
+
+			continue
+		}
+
+		r.warn("security_policy", item.Name, "doesn't exist and can't be created, groups will use the default policy")
+	}
+
+	return nil
+}
+
+func (r *restorer) restoreUsers(ctx context.Context, snap *snapshot) error {
+	existing := make(map[string]*model.User, len(r.network.Users))
+	for _, user := range r.network.Users {
+		existing[user.Email] = user
+	}
+
+	for _, item := range snap.Users {
+		if live, ok := existing[item.Email]; ok {
+			r.ids[item.ID] = live.ID
+
+			continue
+		}
+
+		if item.Type != model.UserTypeManual {
+			r.warn("user", item.Email, "is synced from the identity provider and can't be created")
+
+			continue
+		}
+
+		err := r.create(item.ID, "user", item.Email, func() (string, error) {
+			created, err := r.client.CreateUser(ctx, &model.User{
+				Email:     item.Email,
+				FirstName: item.FirstName,
+				LastName:  item.LastName,
+				Role:      item.Role,
+			})
+			if err != nil {
+				return "", err
+			}
+
+			return created.ID, nil
+		})
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (r *restorer) restoreServiceAccounts(ctx context.Context, snap *snapshot) error {
+	existing := indexByName(r.network.ServiceAccounts)
+
+	for _, item := range snap.ServiceAccounts {
+		if live, ok := existing[item.Name]; ok {
+			r.ids[item.ID] = live.ID
+
+			continue
+		}
+
+		err := r.create(item.ID, "service_account", item.Name, func() (string, error) {
+			created, err := r.client.CreateServiceAccount(ctx, item.Name)
+			if err != nil {
+				return "", err
+			}
+
+			return created.ID, nil
+		})
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (r *restorer) restoreGroups(ctx context.Context, snap *snapshot) error {
+	existing := indexByName(r.network.Groups)
+
+	for _, item := range snap.Groups {
+		if live, ok := existing[item.Name]; ok {
+			r.ids[item.ID] = live.ID
+
+			continue
+		}
+
+		if item.Type != model.GroupTypeManual {
+			r.warn("group", item.Name, fmt.Sprintf("is a %s group and can't be created", item.Type))
+
+			continue
+		}
+
+		group := &model.Group{
+			Name:             item.Name,
+			Users:            r.relink("group", item.Name, item.UserIDs),
+			SecurityPolicyID: r.ids[item.SecurityPolicyID],
+		}
+
+		err := r.create(item.ID, "group", item.Name, func() (string, error) {
+			created, err := r.client.CreateGroup(ctx, group)
+			if err != nil {
+				return "", err
+			}
+
+			return created.ID, nil
+		})
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (r *restorer) restoreConnectors(ctx context.Context, snap *snapshot) error {
+	existing := indexByName(r.network.Connectors)
+
+	for _, item := range snap.Connectors {
+		if live, ok := existing[item.Name]; ok {
+			r.ids[item.ID] = live.ID
+
+			continue
+		}
+
+		remoteNetworkID, ok := r.ids[item.RemoteNetworkID]
+		if !ok {
+			r.warn("connector", item.Name, "references a remote network missing in the snapshot")
+
+			continue
+		}
+
+		err := r.create(item.ID, "connector", item.Name, func() (string, error) {
+			created, err := r.client.CreateConnector(ctx, &model.Connector{
+				Name:                 item.Name,
+				NetworkID:            remoteNetworkID,
+				StatusUpdatesEnabled: item.StatusUpdatesEnabled,
+			})
+			if err != nil {
+				return "", err
+			}
+
+			return created.ID, nil
+		})
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (r *restorer) restoreResources(ctx context.Context, snap *snapshot) error {
+	type resourceKey struct{ remoteNetworkID, name string }
+
+	existing := make(map[resourceKey]*model.Resource, len(r.network.Resources))
+	for _, res := range r.network.Resources {
+		existing[resourceKey{res.RemoteNetworkID, res.Name}] = res
+	}
+
+	for _, item := range snap.Resources {
+		remoteNetworkID, ok := r.ids[item.RemoteNetworkID]
+		if !ok {
+			r.warn("resource", item.Name, "references a remote network missing in the snapshot")
+
+			continue
+		}
+
+		if live, ok := existing[resourceKey{remoteNetworkID, item.Name}]; ok {
+			r.ids[item.ID] = live.ID
+
+			continue
+		}
+
+		protocols, err := item.Protocols.toModel()
+		if err != nil {
+			return fmt.Errorf("invalid protocols of resource %q: %w", item.Name, err)
+		}
+
+		res := &model.Resource{
+			Name:                     item.Name,
+			Address:                  item.Address,
+			RemoteNetworkID:          remoteNetworkID,
+			Alias:                    item.Alias,
+			IsVisible:                item.IsVisible,
+			IsBrowserShortcutEnabled: item.IsBrowserShortcutEnabled,
+			Protocols:                protocols,
+			Groups:                   r.relink("resource", item.Name, item.GroupIDs),
+			ServiceAccounts:          r.relink("resource", item.Name, item.ServiceAccountIDs),
+		}
+
+		err = r.create(item.ID, "resource", item.Name, func() (string, error) {
+			created, err := r.client.CreateResource(ctx, res)
+			if err != nil {
+				return "", err
+			}
+
+			if err := r.client.AddResourceServiceAccountIDs(ctx, created); err != nil {
+				return "", err
+			}
+
+			return created.ID, nil
+		})
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// create - prints the planned object, and creates it unless it's a dry run.
+// In dry run the snapshot ID stands in for the ID of the object which would be created.
+func (r *restorer) create(snapshotID, kind, name string, create func() (string, error)) error {
+	fmt.Fprintf(r.out, "+ %s %q\n", kind, name)
+
+	r.created++
+
+	if r.dryRun {
+		r.ids[snapshotID] = snapshotID
+
+		return nil
+	}
+
+	id, err := create()
+	if err != nil {
+		return fmt.Errorf("failed to restore %s %q: %w", kind, name, err)
+	}
+
+	r.ids[snapshotID] = id
+
+	return nil
+}
+
+func (r *restorer) warn(kind, name, message string) {
+	fmt.Fprintf(r.out, "! %s %q %s\n", kind, name, message)
+
+	r.warnings++
+}
+
+// relink - translates snapshot IDs to IDs of the network, dropping references which can't be resolved.
+func (r *restorer) relink(kind, name string, snapshotIDs []string) []string {
+	result := make([]string, 0, len(snapshotIDs))
+
+	for _, snapshotID := range snapshotIDs {
+		id, ok := r.ids[snapshotID]
+		if !ok {
+			r.warn(kind, name, fmt.Sprintf("references %s which can't be restored", snapshotID))
+
+			continue
+		}
+
+		result = append(result, id)
+	}
+
+	return result
+}
+
+func indexByName[T named](items []T) map[string]T {
+	result := make(map[string]T, len(items))
+	for _, item := range items {
+		if _, ok := result[item.GetName()]; !ok {
+			result[item.GetName()] = item
+		}
+	}
+
+	return result
+}
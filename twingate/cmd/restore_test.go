@@ -0,0 +1,135 @@
+package cmd
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/Twingate/terraform-provider-twingate/twingate/internal/model"
+	"github.com/stretchr/testify/assert"
+)
+
+// fakeRestoreClient - records created objects, assigning them sequential IDs.
+type fakeRestoreClient struct {
+	created   []string
+	resources []*model.Resource
+	groups    []*model.Group
+	accounts  map[string][]string
+}
+
+func (f *fakeRestoreClient) newID(kind, name string) string {
+	f.created = append(f.created, fmt.Sprintf("%s %s", kind, name))
+
+	return fmt.Sprintf("new-%s-%d", kind, len(f.created))
+}
+
+func (f *fakeRestoreClient) CreateRemoteNetwork(_ context.Context, req *model.RemoteNetwork) (*model.RemoteNetwork, error) {
+	return &model.RemoteNetwork{ID: f.newID("remote_network", req.Name), Name: req.Name}, nil
+}
+
+func (f *fakeRestoreClient) CreateUser(_ context.Context, input *model.User) (*model.User, error) {
+	return &model.User{ID: f.newID("user", input.Email), Email: input.Email}, nil
+}
+
+func (f *fakeRestoreClient) CreateServiceAccount(_ context.Context, name string) (*model.ServiceAccount, error) {
+	return &model.ServiceAccount{ID: f.newID("service_account", name), Name: name}, nil
+}
+
+func (f *fakeRestoreClient) CreateGroup(_ context.Context, input *model.Group) (*model.Group, error) {
+	f.groups = append(f.groups, input)
+
+	return &model.Group{ID: f.newID("group", input.Name), Name: input.Name}, nil
+}
+
+func (f *fakeRestoreClient) CreateConnector(_ context.Context, input *model.Connector) (*model.Connector, error) {
+	return &model.Connector{ID: f.newID("connector", input.Name), Name: input.Name}, nil
+}
+
+func (f *fakeRestoreClient) CreateResource(_ context.Context, input *model.Resource) (*model.Resource, error) {
+	f.resources = append(f.resources, input)
+
+	created := *input
+	created.ID = f.newID("resource", input.Name)
+
+	return &created, nil
+}
+
+func (f *fakeRestoreClient) AddResourceServiceAccountIDs(_ context.Context, resource *model.Resource) error {
+	if f.accounts == nil {
+		f.accounts = make(map[string][]string)
+	}
+
+	f.accounts[resource.ID] = resource.ServiceAccounts
+
+	return nil
+}
+
+func newRestoreSnapshot() *snapshot {
+	network := newReportTenant()
+	network.Users[0].Type = model.UserTypeManual
+	network.Users[1].Type = model.UserTypeSynced
+	network.Groups[0].Type = model.GroupTypeManual
+	network.Groups[1].Type = model.GroupTypeSynced
+
+	return newSnapshot("acme", network, time.Now())
+}
+
+func newRestoreTenant() *tenant {
+	return &tenant{
+		RemoteNetworks: []*model.RemoteNetwork{{ID: "live-network-1", Name: "Office"}},
+		Users:          []*model.User{{ID: "live-user-1", Email: "alice@example.com"}},
+	}
+}
+
+func TestRestoreDryRun(t *testing.T) {
+	var (
+		out    bytes.Buffer
+		client fakeRestoreClient
+	)
+
+	assert.NoError(t, newRestorer(&client, newRestoreTenant(), &out, true).restore(context.Background(), newRestoreSnapshot()))
+
+	assert.Empty(t, client.created)
+	assert.Equal(t, `+ remote_network "AWS"
+! user "bob@example.com" is synced from the identity provider and can't be created
++ service_account "CI"
+! group "Engineering" references user-2 which can't be restored
++ group "Engineering"
+! group "Admins" is a SYNCED group and can't be created
+! resource "DB" references group-2 which can't be restored
++ resource "DB"
+! resource "Web" references group-2 which can't be restored
++ resource "Web"
+
+Restore plan: 5 to create, 5 warnings.
+`, out.String())
+}
+
+func TestRestoreRelinksIDs(t *testing.T) {
+	var (
+		out    bytes.Buffer
+		client fakeRestoreClient
+	)
+
+	assert.NoError(t, newRestorer(&client, newRestoreTenant(), &out, false).restore(context.Background(), newRestoreSnapshot()))
+
+	assert.Equal(t, []string{
+		"remote_network AWS",
+		"service_account CI",
+		"group Engineering",
+		"resource DB",
+		"resource Web",
+	}, client.created)
+
+	assert.Equal(t, []string{"live-user-1"}, client.groups[0].Users)
+
+	assert.Equal(t, "live-network-1", client.resources[0].RemoteNetworkID)
+	assert.Equal(t, []string{"new-group-3"}, client.resources[0].Groups)
+	assert.Equal(t, []string{"new-service_account-2"}, client.accounts["new-resource-4"])
+	assert.Equal(t, model.PolicyRestricted, client.resources[0].Protocols.TCP.Policy)
+
+	assert.Equal(t, "new-remote_network-1", client.resources[1].RemoteNetworkID)
+	assert.Contains(t, out.String(), "Restore complete: 5 created, 5 warnings.")
+}
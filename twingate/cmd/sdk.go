@@ -0,0 +1,244 @@
+package cmd
+
+import (
+	"context"
+
+	"github.com/Twingate/terraform-provider-twingate/twingate/internal/model"
+	"github.com/Twingate/terraform-provider-twingate/twingate/internal/utils"
+	"github.com/Twingate/terraform-provider-twingate/twingate/sdk"
+)
+
+// sdkClient - implements the operations of replicate and restore with the sdk client,
+// converting between the models used by the commands and the sdk.
+type sdkClient struct {
+	client *sdk.Client
+}
+
+func (c *sdkClient) CreateRemoteNetwork(ctx context.Context, req *model.RemoteNetwork) (*model.RemoteNetwork, error) {
+	created, err := c.client.CreateRemoteNetwork(ctx, &sdk.RemoteNetwork{Name: req.Name, Location: req.Location})
+	if err != nil {
+		return nil, err //nolint:wrapcheck
+	}
+
+	return remoteNetworkFromSDK(created), nil
+}
+
+func (c *sdkClient) UpdateRemoteNetwork(ctx context.Context, req *model.RemoteNetwork) (*model.RemoteNetwork, error) {
+	updated, err := c.client.UpdateRemoteNetwork(ctx, &sdk.RemoteNetwork{ID: req.ID, Name: req.Name, Location: req.Location})
+	if err != nil {
+		return nil, err //nolint:wrapcheck
+	}
+
+	return remoteNetworkFromSDK(updated), nil
+}
+
+func (c *sdkClient) CreateUser(ctx context.Context, input *model.User) (*model.User, error) {
+	created, err := c.client.CreateUser(ctx, &sdk.User{
+		FirstName:  input.FirstName,
+		LastName:   input.LastName,
+		Email:      input.Email,
+		Role:       input.Role,
+		SendInvite: input.SendInvite,
+	})
+	if err != nil {
+		return nil, err //nolint:wrapcheck
+	}
+
+	return userFromSDK(created), nil
+}
+
+func (c *sdkClient) CreateServiceAccount(ctx context.Context, serviceAccountName string) (*model.ServiceAccount, error) {
+	created, err := c.client.CreateServiceAccount(ctx, serviceAccountName)
+	if err != nil {
+		return nil, err //nolint:wrapcheck
+	}
+
+	return serviceAccountFromSDK(created), nil
+}
+
+func (c *sdkClient) CreateGroup(ctx context.Context, input *model.Group) (*model.Group, error) {
+	created, err := c.client.CreateGroup(ctx, &sdk.Group{
+		Name:             input.Name,
+		Users:            input.Users,
+		SecurityPolicyID: input.SecurityPolicyID,
+	})
+	if err != nil {
+		return nil, err //nolint:wrapcheck
+	}
+
+	return groupFromSDK(created), nil
+}
+
+func (c *sdkClient) CreateConnector(ctx context.Context, input *model.Connector) (*model.Connector, error) {
+	created, err := c.client.CreateConnector(ctx, &sdk.Connector{
+		Name:                 input.Name,
+		RemoteNetworkID:      input.NetworkID,
+		StatusUpdatesEnabled: input.StatusUpdatesEnabled,
+	})
+	if err != nil {
+		return nil, err //nolint:wrapcheck
+	}
+
+	return connectorFromSDK(created), nil
+}
+
+func (c *sdkClient) CreateResource(ctx context.Context, input *model.Resource) (*model.Resource, error) {
+	created, err := c.client.CreateResource(ctx, resourceToSDK(input))
+	if err != nil {
+		return nil, err //nolint:wrapcheck
+	}
+
+	return resourceFromSDK(created), nil
+}
+
+func (c *sdkClient) UpdateResource(ctx context.Context, input *model.Resource) (*model.Resource, error) {
+	updated, err := c.client.UpdateResource(ctx, resourceToSDK(input))
+	if err != nil {
+		return nil, err //nolint:wrapcheck
+	}
+
+	return resourceFromSDK(updated), nil
+}
+
+func (c *sdkClient) DeleteResourceGroups(ctx context.Context, resourceID string, deleteGroupIDs []string) error {
+	return c.client.RemoveResourceGroups(ctx, resourceID, deleteGroupIDs) //nolint:wrapcheck
+}
+
+// AddResourceServiceAccountIDs - gives the service accounts of the resource access to it,
+// the sdk adds resources of service accounts on update.
+func (c *sdkClient) AddResourceServiceAccountIDs(ctx context.Context, resource *model.Resource) error {
+	for _, serviceAccountID := range resource.ServiceAccounts {
+		if _, err := c.client.UpdateServiceAccount(ctx, &sdk.ServiceAccount{
+			ID:        serviceAccountID,
+			Resources: []string{resource.ID},
+		}); err != nil {
+			return err //nolint:wrapcheck
+		}
+	}
+
+	return nil
+}
+
+func remoteNetworkFromSDK(network *sdk.RemoteNetwork) *model.RemoteNetwork {
+	return &model.RemoteNetwork{ID: network.ID, Name: network.Name, Location: network.Location}
+}
+
+func connectorFromSDK(connector *sdk.Connector) *model.Connector {
+	return &model.Connector{
+		ID:                   connector.ID,
+		Name:                 connector.Name,
+		NetworkID:            connector.RemoteNetworkID,
+		StatusUpdatesEnabled: connector.StatusUpdatesEnabled,
+	}
+}
+
+func groupFromSDK(group *sdk.Group) *model.Group {
+	return &model.Group{
+		ID:               group.ID,
+		Name:             group.Name,
+		Type:             group.Type,
+		IsActive:         group.IsActive,
+		Users:            group.Users,
+		SecurityPolicyID: group.SecurityPolicyID,
+	}
+}
+
+func userFromSDK(user *sdk.User) *model.User {
+	return &model.User{
+		ID:         user.ID,
+		FirstName:  user.FirstName,
+		LastName:   user.LastName,
+		Email:      user.Email,
+		Role:       user.Role,
+		Type:       user.Type,
+		SendInvite: user.SendInvite,
+		IsActive:   user.IsActive,
+	}
+}
+
+func serviceAccountFromSDK(account *sdk.ServiceAccount) *model.ServiceAccount {
+	return &model.ServiceAccount{ID: account.ID, Name: account.Name, Resources: account.Resources, Keys: account.Keys}
+}
+
+func securityPolicyFromSDK(policy *sdk.SecurityPolicy) *model.SecurityPolicy {
+	return &model.SecurityPolicy{ID: policy.ID, Name: policy.Name}
+}
+
+// resourceFromSDK - service accounts are not read with the resources of the sdk, see loadTenant.
+func resourceFromSDK(resource *sdk.Resource) *model.Resource {
+	return &model.Resource{
+		ID:                       resource.ID,
+		Name:                     resource.Name,
+		Address:                  resource.Address,
+		Alias:                    resource.Alias,
+		RemoteNetworkID:          resource.RemoteNetworkID,
+		Protocols:                protocolsFromSDK(resource.Protocols),
+		IsActive:                 resource.IsActive,
+		IsVisible:                resource.IsVisible,
+		IsBrowserShortcutEnabled: resource.IsBrowserShortcutEnabled,
+		Groups:                   resource.Groups,
+	}
+}
+
+func resourceToSDK(resource *model.Resource) *sdk.Resource {
+	return &sdk.Resource{
+		ID:                       resource.ID,
+		Name:                     resource.Name,
+		Address:                  resource.Address,
+		Alias:                    resource.Alias,
+		RemoteNetworkID:          resource.RemoteNetworkID,
+		Protocols:                protocolsToSDK(resource.Protocols),
+		IsActive:                 resource.IsActive,
+		IsVisible:                resource.IsVisible,
+		IsBrowserShortcutEnabled: resource.IsBrowserShortcutEnabled,
+		Groups:                   resource.Groups,
+	}
+}
+
+func protocolsFromSDK(protocols *sdk.Protocols) *model.Protocols {
+	if protocols == nil {
+		return nil
+	}
+
+	return &model.Protocols{
+		AllowIcmp: protocols.AllowIcmp,
+		TCP:       protocolFromSDK(protocols.TCP),
+		UDP:       protocolFromSDK(protocols.UDP),
+	}
+}
+
+func protocolFromSDK(protocol *sdk.Protocol) *model.Protocol {
+	if protocol == nil {
+		return nil
+	}
+
+	return model.NewProtocol(protocol.Policy, utils.Map[sdk.PortRange, *model.PortRange](protocol.Ports, func(port sdk.PortRange) *model.PortRange {
+		return &model.PortRange{Start: port.Start, End: port.End}
+	}))
+}
+
+func protocolsToSDK(protocols *model.Protocols) *sdk.Protocols {
+	if protocols == nil {
+		return nil
+	}
+
+	return &sdk.Protocols{
+		AllowIcmp: protocols.AllowIcmp,
+		TCP:       protocolToSDK(protocols.TCP),
+		UDP:       protocolToSDK(protocols.UDP),
+	}
+}
+
+// protocolToSDK - ports of service presets are sent as ports, the sdk has no presets.
+func protocolToSDK(protocol *model.Protocol) *sdk.Protocol {
+	if protocol == nil {
+		return nil
+	}
+
+	result := &sdk.Protocol{Policy: protocol.Policy}
+	for _, port := range append(append([]*model.PortRange(nil), protocol.Ports...), protocol.ServicePorts...) {
+		result.Ports = append(result.Ports, sdk.PortRange{Start: port.Start, End: port.End})
+	}
+
+	return result
+}
@@ -0,0 +1,318 @@
+package cmd
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"time"
+
+	"github.com/Twingate/terraform-provider-twingate/twingate/internal/model"
+)
+
+// snapshotVersion - bumped on incompatible changes of the snapshot format.
+const snapshotVersion = 1
+
+var ErrSnapshotVersion = errors.New("unsupported snapshot version")
+
+// snapshot - all objects of a network, references between objects use the IDs of the snapshotted network.
+type snapshot struct {
+	Version          int                       `json:"version"`
+	Network          string                    `json:"network"`
+	CreatedAt        time.Time                 `json:"created_at"`
+	RemoteNetworks   []*snapshotRemoteNetwork  `json:"remote_networks"`
+	Connectors       []*snapshotConnector      `json:"connectors"`
+	Resources        []*snapshotResource       `json:"resources"`
+	Groups           []*snapshotGroup          `json:"groups"`
+	Users            []*snapshotUser           `json:"users"`
+	ServiceAccounts  []*snapshotServiceAccount `json:"service_accounts"`
+	SecurityPolicies []*snapshotSecurityPolicy `json:"security_policies"`
+}
+
+type snapshotRemoteNetwork struct {
+	ID       string `json:"id"`
+	Name     string `json:"name"`
+	Location string `json:"location"`
+}
+
+type snapshotConnector struct {
+	ID                   string `json:"id"`
+	Name                 string `json:"name"`
+	RemoteNetworkID      string `json:"remote_network_id"`
+	StatusUpdatesEnabled *bool  `json:"status_updates_enabled,omitempty"`
+}
+
+type snapshotResource struct {
+	ID                       string             `json:"id"`
+	Name                     string             `json:"name"`
+	Address                  string             `json:"address"`
+	RemoteNetworkID          string             `json:"remote_network_id"`
+	Alias                    *string            `json:"alias,omitempty"`
+	IsVisible                *bool              `json:"is_visible,omitempty"`
+	IsBrowserShortcutEnabled *bool              `json:"is_browser_shortcut_enabled,omitempty"`
+	Protocols                *snapshotProtocols `json:"protocols,omitempty"`
+	GroupIDs                 []string           `json:"group_ids"`
+	ServiceAccountIDs        []string           `json:"service_account_ids"`
+}
+
+type snapshotProtocols struct {
+	AllowIcmp bool              `json:"allow_icmp"`
+	TCP       *snapshotProtocol `json:"tcp"`
+	UDP       *snapshotProtocol `json:"udp"`
+}
+
+type snapshotProtocol struct {
+	Policy string   `json:"policy"`
+	Ports  []string `json:"ports,omitempty"`
+}
+
+type snapshotGroup struct {
+	ID               string   `json:"id"`
+	Name             string   `json:"name"`
+	Type             string   `json:"type"`
+	UserIDs          []string `json:"user_ids"`
+	SecurityPolicyID string   `json:"security_policy_id,omitempty"`
+}
+
+type snapshotUser struct {
+	ID        string `json:"id"`
+	Email     string `json:"email"`
+	FirstName string `json:"first_name,omitempty"`
+	LastName  string `json:"last_name,omitempty"`
+	Role      string `json:"role"`
+	Type      string `json:"type"`
+}
+
+type snapshotServiceAccount struct {
+	ID   string `json:"id"`
+	Name string `json:"name"`
+}
+
+type snapshotSecurityPolicy struct {
+	ID   string `json:"id"`
+	Name string `json:"name"`
+}
+
+func snapshotCommand() *command {
+	return &command{
+		name:        "snapshot",
+		description: "write all objects of the network to a versioned JSON file",
+		run:         runSnapshot,
+	}
+}
+
+func runSnapshot(ctx context.Context, env *environment, args []string) error {
+	flags := newFlagSet("snapshot", env)
+
+	var conn clientFlags
+	conn.register(flags, "")
+
+	out := flags.String("out", "", "file to write the snapshot to, defaults to stdout")
+
+	if err := flags.Parse(args); err != nil {
+		return err //nolint:wrapcheck
+	}
+
+	c, err := conn.newClient(env.version)
+	if err != nil {
+		return err
+	}
+
+	network, err := loadTenant(ctx, c)
+	if err != nil {
+		return err
+	}
+
+	snap := newSnapshot(conn.network, network, time.Now().UTC())
+
+	if *out == "" {
+		return writeSnapshot(env.stdout, snap)
+	}
+
+	file, err := os.OpenFile(*out, os.O_CREATE|os.O_WRONLY|os.O_TRUNC, reportFileMode)
+	if err != nil {
+		return fmt.Errorf("failed to create %s: %w", *out, err)
+	}
+
+	if err := writeSnapshot(file, snap); err != nil {
+		_ = file.Close()
+
+		return err
+	}
+
+	return file.Close() //nolint:wrapcheck
+}
+
+func writeSnapshot(out io.Writer, snap *snapshot) error {
+	encoder := json.NewEncoder(out)
+	encoder.SetIndent("", "  ")
+
+	if err := encoder.Encode(snap); err != nil {
+		return fmt.Errorf("failed to write snapshot: %w", err)
+	}
+
+	return nil
+}
+
+func readSnapshot(in io.Reader) (*snapshot, error) {
+	var snap snapshot
+	if err := json.NewDecoder(in).Decode(&snap); err != nil {
+		return nil, fmt.Errorf("failed to read snapshot: %w", err)
+	}
+
+	if snap.Version != snapshotVersion {
+		return nil, fmt.Errorf("%w: %d, expected %d", ErrSnapshotVersion, snap.Version, snapshotVersion)
+	}
+
+	return &snap, nil
+}
+
+func newSnapshot(networkName string, network *tenant, createdAt time.Time) *snapshot {
+	snap := &snapshot{
+		Version:          snapshotVersion,
+		Network:          networkName,
+		CreatedAt:        createdAt,
+		RemoteNetworks:   make([]*snapshotRemoteNetwork, 0, len(network.RemoteNetworks)),
+		Connectors:       make([]*snapshotConnector, 0, len(network.Connectors)),
+		Resources:        make([]*snapshotResource, 0, len(network.Resources)),
+		Groups:           make([]*snapshotGroup, 0, len(network.Groups)),
+		Users:            make([]*snapshotUser, 0, len(network.Users)),
+		ServiceAccounts:  make([]*snapshotServiceAccount, 0, len(network.ServiceAccounts)),
+		SecurityPolicies: make([]*snapshotSecurityPolicy, 0, len(network.SecurityPolicies)),
+	}
+
+	for _, remoteNetwork := range network.RemoteNetworks {
+		snap.RemoteNetworks = append(snap.RemoteNetworks, &snapshotRemoteNetwork{
+			ID:       remoteNetwork.ID,
+			Name:     remoteNetwork.Name,
+			Location: remoteNetwork.Location,
+		})
+	}
+
+	for _, connector := range network.Connectors {
+		snap.Connectors = append(snap.Connectors, &snapshotConnector{
+			ID:                   connector.ID,
+			Name:                 connector.Name,
+			RemoteNetworkID:      connector.NetworkID,
+			StatusUpdatesEnabled: connector.StatusUpdatesEnabled,
+		})
+	}
+
+	for _, res := range network.Resources {
+		snap.Resources = append(snap.Resources, &snapshotResource{
+			ID:                       res.ID,
+			Name:                     res.Name,
+			Address:                  res.Address,
+			RemoteNetworkID:          res.RemoteNetworkID,
+			Alias:                    res.Alias,
+			IsVisible:                res.IsVisible,
+			IsBrowserShortcutEnabled: res.IsBrowserShortcutEnabled,
+			Protocols:                newSnapshotProtocols(res.Protocols),
+			GroupIDs:                 nonNil(res.Groups),
+			ServiceAccountIDs:        nonNil(res.ServiceAccounts),
+		})
+	}
+
+	for _, group := range network.Groups {
+		snap.Groups = append(snap.Groups, &snapshotGroup{
+			ID:               group.ID,
+			Name:             group.Name,
+			Type:             group.Type,
+			UserIDs:          nonNil(group.Users),
+			SecurityPolicyID: group.SecurityPolicyID,
+		})
+	}
+
+	for _, user := range network.Users {
+		snap.Users = append(snap.Users, &snapshotUser{
+			ID:        user.ID,
+			Email:     user.Email,
+			FirstName: user.FirstName,
+			LastName:  user.LastName,
+			Role:      user.Role,
+			Type:      user.Type,
+		})
+	}
+
+	for _, account := range network.ServiceAccounts {
+		snap.ServiceAccounts = append(snap.ServiceAccounts, &snapshotServiceAccount{ID: account.ID, Name: account.Name})
+	}
+
+	for _, policy := range network.SecurityPolicies {
+		snap.SecurityPolicies = append(snap.SecurityPolicies, &snapshotSecurityPolicy{ID: policy.ID, Name: policy.Name})
+	}
+
+	return snap
+}
+
+func newSnapshotProtocols(protocols *model.Protocols) *snapshotProtocols {
+	if protocols == nil {
+		return nil
+	}
+
+	return &snapshotProtocols{
+		AllowIcmp: protocols.AllowIcmp,
+		TCP:       newSnapshotProtocol(protocols.TCP),
+		UDP:       newSnapshotProtocol(protocols.UDP),
+	}
+}
+
+func newSnapshotProtocol(protocol *model.Protocol) *snapshotProtocol {
+	if protocol == nil {
+		protocol = model.DefaultProtocol()
+	}
+
+	result := &snapshotProtocol{Policy: protocol.EffectivePolicy()}
+	for _, port := range protocol.NormalizedPorts() {
+		result.Ports = append(result.Ports, port.String())
+	}
+
+	return result
+}
+
+func (p *snapshotProtocols) toModel() (*model.Protocols, error) {
+	if p == nil {
+		return model.DefaultProtocols(), nil
+	}
+
+	tcp, err := p.TCP.toModel()
+	if err != nil {
+		return nil, err
+	}
+
+	udp, err := p.UDP.toModel()
+	if err != nil {
+		return nil, err
+	}
+
+	return &model.Protocols{AllowIcmp: p.AllowIcmp, TCP: tcp, UDP: udp}, nil
+}
+
+func (p *snapshotProtocol) toModel() (*model.Protocol, error) {
+	if p == nil {
+		return model.DefaultProtocol(), nil
+	}
+
+	ports := make([]*model.PortRange, 0, len(p.Ports))
+
+	for _, value := range p.Ports {
+		port, err := model.NewPortRange(value)
+		if err != nil {
+			return nil, err //nolint:wrapcheck
+		}
+
+		ports = append(ports, port)
+	}
+
+	return model.NewProtocol(p.Policy, ports), nil
+}
+
+func nonNil(items []string) []string {
+	if items == nil {
+		return []string{}
+	}
+
+	return items
+}
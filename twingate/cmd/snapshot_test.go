@@ -0,0 +1,42 @@
+package cmd
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/Twingate/terraform-provider-twingate/twingate/internal/model"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSnapshotRoundTrip(t *testing.T) {
+	network := newReportTenant()
+	network.SecurityPolicies = []*model.SecurityPolicy{{ID: "policy-1", Name: "Strict"}}
+	network.Groups[0].SecurityPolicyID = "policy-1"
+
+	snap := newSnapshot("acme", network, time.Date(2023, 6, 1, 0, 0, 0, 0, time.UTC))
+
+	var out bytes.Buffer
+
+	assert.NoError(t, writeSnapshot(&out, snap))
+
+	actual, err := readSnapshot(&out)
+	assert.NoError(t, err)
+	assert.Equal(t, snap, actual)
+
+	assert.Equal(t, []string{"22", "5432"}, actual.Resources[0].Protocols.TCP.Ports)
+	assert.Equal(t, model.PolicyDenyAll, actual.Resources[0].Protocols.UDP.Policy)
+	assert.Nil(t, actual.Resources[1].Protocols)
+	assert.Equal(t, []string{}, actual.Resources[1].ServiceAccountIDs)
+
+	protocols, err := actual.Resources[0].Protocols.toModel()
+	assert.NoError(t, err)
+	assert.True(t, protocols.Equal(network.Resources[0].Protocols))
+}
+
+func TestReadSnapshotUnsupportedVersion(t *testing.T) {
+	_, err := readSnapshot(strings.NewReader(`{"version": 2}`))
+
+	assert.ErrorIs(t, err, ErrSnapshotVersion)
+}
@@ -0,0 +1,119 @@
+package cmd
+
+import (
+	"context"
+	"sort"
+
+	"github.com/Twingate/terraform-provider-twingate/twingate/internal/model"
+	"github.com/Twingate/terraform-provider-twingate/twingate/internal/utils"
+	"github.com/Twingate/terraform-provider-twingate/twingate/sdk"
+)
+
+// tenant - all objects of a Twingate network.
+type tenant struct {
+	RemoteNetworks   []*model.RemoteNetwork
+	Connectors       []*model.Connector
+	Resources        []*model.Resource
+	Groups           []*model.Group
+	Users            []*model.User
+	ServiceAccounts  []*model.ServiceAccount
+	SecurityPolicies []*model.SecurityPolicy
+}
+
+// loadTenant - reads all objects of the network with the sdk client.
+func loadTenant(ctx context.Context, c *sdk.Client) (*tenant, error) {
+	var (
+		t   tenant
+		err error
+	)
+
+	if t.RemoteNetworks, err = collect(ctx, c.RemoteNetworks(), remoteNetworkFromSDK); err != nil {
+		return nil, err
+	}
+
+	if t.Connectors, err = collect(ctx, c.Connectors(), connectorFromSDK); err != nil {
+		return nil, err
+	}
+
+	if t.Resources, err = collect(ctx, c.Resources(), resourceFromSDK); err != nil {
+		return nil, err
+	}
+
+	if t.Groups, err = collect(ctx, c.Groups(nil), groupFromSDK); err != nil {
+		return nil, err
+	}
+
+	if t.Users, err = collect(ctx, c.Users(), userFromSDK); err != nil {
+		return nil, err
+	}
+
+	if t.ServiceAccounts, err = collect(ctx, c.ServiceAccounts(), serviceAccountFromSDK); err != nil {
+		return nil, err
+	}
+
+	if t.SecurityPolicies, err = collect(ctx, c.SecurityPolicies(), securityPolicyFromSDK); err != nil {
+		return nil, err
+	}
+
+	t.linkServiceAccounts()
+	t.sort()
+
+	return &t, nil
+}
+
+// collect - all objects of the iterator, converted into the models used by the commands.
+func collect[T, M any](ctx context.Context, it *sdk.Iterator[T], convert func(T) M) ([]M, error) {
+	items, err := it.Collect(ctx)
+	if err != nil {
+		return nil, err //nolint:wrapcheck
+	}
+
+	return utils.Map(items, convert), nil
+}
+
+// linkServiceAccounts - sets service accounts of resources, which are only read with the service accounts.
+func (t *tenant) linkServiceAccounts() {
+	for _, resource := range t.Resources {
+		for _, account := range t.ServiceAccounts {
+			if utils.Contains(account.Resources, resource.ID) {
+				resource.ServiceAccounts = append(resource.ServiceAccounts, account.ID)
+			}
+		}
+	}
+}
+
+// sort - by name, to produce stable output.
+func (t *tenant) sort() {
+	sortByName(t.RemoteNetworks)
+	sortByName(t.Connectors)
+	sortByName(t.Resources)
+	sortByName(t.Groups)
+	sortByName(t.Users)
+	sortByName(t.ServiceAccounts)
+	sortByName(t.SecurityPolicies)
+}
+
+func (t *tenant) remoteNetworkName(id string) string {
+	for _, network := range t.RemoteNetworks {
+		if network.ID == id {
+			return network.Name
+		}
+	}
+
+	return id
+}
+
+type named interface {
+	GetID() string
+	GetName() string
+}
+
+func sortByName[T named](items []T) {
+	sort.SliceStable(items, func(i, j int) bool {
+		if items[i].GetName() == items[j].GetName() {
+			return items[i].GetID() < items[j].GetID()
+		}
+
+		return items[i].GetName() < items[j].GetName()
+	})
+}
@@ -0,0 +1,56 @@
+package cmd
+
+import (
+	"context"
+	"testing"
+
+	"github.com/Twingate/terraform-provider-twingate/twingate/internal/model"
+	"github.com/Twingate/terraform-provider-twingate/twingate/sdk"
+	"github.com/Twingate/terraform-provider-twingate/twingate/sdk/fake"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestLoadTenant(t *testing.T) {
+	server := fake.NewServer()
+	defer server.Close()
+
+	c, err := server.NewClient(sdk.WithHTTPMaxRetry(0))
+	require.NoError(t, err)
+
+	ctx := context.Background()
+	writer := &sdkClient{client: c}
+
+	network, err := writer.CreateRemoteNetwork(ctx, &model.RemoteNetwork{Name: "office", Location: model.LocationOther})
+	require.NoError(t, err)
+
+	group, err := writer.CreateGroup(ctx, &model.Group{Name: "engineering"})
+	require.NoError(t, err)
+
+	account, err := writer.CreateServiceAccount(ctx, "ci")
+	require.NoError(t, err)
+
+	resource, err := writer.CreateResource(ctx, &model.Resource{
+		Name:            "db",
+		Address:         "10.0.0.1",
+		RemoteNetworkID: network.ID,
+		Groups:          []string{group.ID},
+		Protocols: &model.Protocols{
+			TCP: &model.Protocol{Policy: model.PolicyRestricted, ServicePorts: []*model.PortRange{{Start: 5432, End: 5432}}},
+			UDP: model.NewProtocol(model.PolicyDenyAll, nil),
+		},
+	})
+	require.NoError(t, err)
+
+	resource.ServiceAccounts = []string{account.ID}
+	require.NoError(t, writer.AddResourceServiceAccountIDs(ctx, resource))
+
+	loaded, err := loadTenant(ctx, c)
+	require.NoError(t, err)
+
+	require.Len(t, loaded.Resources, 1)
+	assert.Equal(t, []*model.RemoteNetwork{network}, loaded.RemoteNetworks)
+	assert.Equal(t, []string{group.ID}, loaded.Resources[0].Groups)
+	assert.Equal(t, []string{account.ID}, loaded.Resources[0].ServiceAccounts)
+	assert.Equal(t, "tcp=5432 udp=none icmp=false", formatProtocols(loaded.Resources[0].Protocols))
+}
@@ -1,9 +1,21 @@
 package attr
 
 const (
-	APIToken     = "api_token"
-	Network      = "network"
-	URL          = "url"
-	HTTPTimeout  = "http_timeout"
-	HTTPMaxRetry = "http_max_retry"
+	APIToken           = "api_token"
+	APITokenFile       = "api_token_file"
+	APITokenCommand    = "api_token_command"
+	Network            = "network"
+	URL                = "url"
+	HTTPTimeout        = "http_timeout"
+	HTTPMaxRetry       = "http_max_retry"
+	HTTPMaxConcurrency = "http_max_concurrency"
+	ProxyURL           = "proxy_url"
+	CACertFile         = "ca_cert_file"
+	CACertPEM          = "ca_cert_pem"
+	InsecureSkipVerify = "insecure_skip_verify"
+	ClientCertFile     = "client_cert_file"
+	ClientKeyFile      = "client_key_file"
+	ClientCertPEM      = "client_cert_pem"
+	ClientKeyPEM       = "client_key_pem"
+	SkipProbe          = "skip_credentials_validation"
 )
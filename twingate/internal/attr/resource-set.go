@@ -0,0 +1,6 @@
+package attr
+
+const (
+	Key            = "key"
+	MaxConcurrency = "max_concurrency"
+)
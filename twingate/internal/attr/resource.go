@@ -15,4 +15,11 @@ const (
 	IsVisible                = "is_visible"
 	IsBrowserShortcutEnabled = "is_browser_shortcut_enabled"
 	Resources                = "resources"
+	Services                 = "services"
+	GroupID                  = "group_id"
+	GroupGrant               = "group_grant"
+	ServiceAccountGrant      = "service_account_grant"
+	ExpiresAt                = "expires_at"
+	ActiveGrants             = "active_grants"
+	CanonicalizeAddress      = "canonicalize_address"
 )
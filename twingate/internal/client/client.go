@@ -7,16 +7,19 @@ import (
 	"errors"
 	"fmt"
 	"io"
-	"log"
 	"net/http"
 	"net/url"
 	"os"
 	"regexp"
 	"strconv"
+	"strings"
 	"time"
 
+	"github.com/Twingate/terraform-provider-twingate/twingate/internal/tracing"
 	"github.com/hashicorp/go-retryablehttp"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/hasura/go-graphql-client"
+	"go.opentelemetry.io/otel/trace"
 )
 
 const (
@@ -26,7 +29,8 @@ const (
 	headerAPIKey = "X-API-KEY"
 	headerAgent  = "User-Agent"
 
-	defaultPageLimit = 50
+	defaultPageLimit      = 50
+	defaultMaxConcurrency = 4
 )
 
 var (
@@ -44,44 +48,134 @@ type Client struct {
 	HTTPClient       *http.Client
 	GraphqlServerURL string
 	APIServerURL     string
-	version          string
-	pageLimit        int
+	// AdoptExisting - provider default for taking ownership of existing objects with the same name on create.
+	AdoptExisting bool
+	// MaxConcurrency - limit of parallel requests made while reading nested pages of many objects, e.g. users of every group.
+	MaxConcurrency int
+	version        string
+	pageLimit      int
+	locks          *keyedMutex
+	transport      *http.Transport
+	auth           *transport
 }
 
 type transport struct {
 	underlineRoundTripper http.RoundTripper
-	apiToken              string
+	tokens                TokenSource
 	version               string
+	logBodies             bool
 }
 
 func (t *transport) RoundTrip(req *http.Request) (*http.Response, error) {
-	if err := t.init(); err != nil {
+	token, err := t.tokens.Token(req.Context())
+	if err != nil {
+		return nil, err //nolint:wrapcheck
+	}
+
+	body, err := readRequestBody(req)
+	if err != nil {
 		return nil, err
 	}
 
-	req.Header.Set(headerAPIKey, t.apiToken)
+	resp, err := t.roundTrip(req, token, body)
+	if err != nil || resp.StatusCode != http.StatusUnauthorized || !t.tokens.Invalidate(token) {
+		return resp, err
+	}
+
+	// the token has expired or was rotated, repeat the request once with a fresh one
+	freshToken, err := t.tokens.Token(req.Context())
+	if err == nil && freshToken == token {
+		return resp, nil
+	}
+
+	_ = resp.Body.Close()
+
+	if err != nil {
+		return nil, err //nolint:wrapcheck
+	}
+
+	return t.roundTrip(req, freshToken, body)
+}
+
+func (t *transport) roundTrip(req *http.Request, token string, body []byte) (*http.Response, error) {
+	ctx := req.Context()
+	req = req.Clone(ctx)
+	req.Header.Set(headerAPIKey, token)
 	req.Header.Set(headerAgent, t.version)
 
-	return t.underlineRoundTripper.RoundTrip(req) //nolint:wrapcheck
+	if body != nil {
+		req.Body = io.NopCloser(bytes.NewReader(body))
+	}
+
+	fields := map[string]interface{}{
+		logFieldMethod: req.Method,
+		logFieldURL:    req.URL.String(),
+	}
+
+	if t.logBodies {
+		tflog.SubsystemTrace(ctx, logSubsystemHTTP, "sending request", fields, map[string]interface{}{
+			logFieldRequestBody: string(body),
+		})
+	}
+
+	start := time.Now()
+	resp, err := t.underlineRoundTripper.RoundTrip(req)
+	fields[logFieldDuration] = durationMs(start)
+
+	if err != nil {
+		fields[logFieldError] = err.Error()
+		tflog.SubsystemDebug(ctx, logSubsystemHTTP, "request failed", fields)
+
+		return nil, err //nolint:wrapcheck
+	}
+
+	fields[logFieldStatus] = resp.StatusCode
+	tflog.SubsystemDebug(ctx, logSubsystemHTTP, "received response", fields)
+
+	if t.logBodies {
+		t.logResponseBody(ctx, resp, fields)
+	}
+
+	return resp, nil
 }
 
-func (t *transport) init() error {
-	if t.apiToken == "" {
-		t.apiToken = os.Getenv(EnvAPIToken)
+// logResponseBody - reads the body for logging and replaces it with a copy.
+func (t *transport) logResponseBody(ctx context.Context, resp *http.Response, fields map[string]interface{}) {
+	body, err := io.ReadAll(resp.Body)
+	_ = resp.Body.Close()
+	resp.Body = io.NopCloser(bytes.NewReader(body))
+
+	if err != nil {
+		return
 	}
 
-	if t.apiToken == "" {
-		return ErrAPITokenNoSet
+	tflog.SubsystemTrace(ctx, logSubsystemHTTP, "response body", fields, map[string]interface{}{
+		logFieldResponseBody: string(body),
+	})
+}
+
+// readRequestBody - buffers the body, so the request can be repeated.
+func readRequestBody(req *http.Request) ([]byte, error) {
+	if req.Body == nil || req.Body == http.NoBody {
+		return nil, nil
 	}
 
-	return nil
+	defer req.Body.Close()
+
+	body, err := io.ReadAll(req.Body)
+	if err != nil {
+		return nil, fmt.Errorf("can't read request body: %w", err)
+	}
+
+	return body, nil
 }
 
 func newTransport(underlineRoundTripper http.RoundTripper, apiToken string, version string) *transport {
 	return &transport{
 		underlineRoundTripper: underlineRoundTripper,
-		apiToken:              apiToken,
+		tokens:                staticToken(apiToken),
 		version:               twingateAgentVersion(version),
+		logBodies:             isBodyLoggingEnabled(),
 	}
 }
 
@@ -102,14 +196,42 @@ type serverURL struct {
 }
 
 func newServerURL(network, url string) serverURL {
+	// URL with a scheme points to a specific server, e.g. a local test server, and is used as it is
+	if strings.Contains(url, "://") {
+		return serverURL{
+			url: strings.TrimSuffix(url, "/"),
+		}
+	}
+
 	return serverURL{
 		url: fmt.Sprintf("https://%s.%s", network, url),
 	}
 }
 
+type contextKey string
+
+const contextKeyNoRetry contextKey = "no-retry"
+
+// withoutRetry - marks requests which are not safe to repeat, e.g. create mutations recovered with recoverCreate,
+// since the server may have committed the first attempt before it failed.
+func withoutRetry(ctx context.Context) context.Context {
+	return context.WithValue(ctx, contextKeyNoRetry, true)
+}
+
+func isRetryable(ctx context.Context) bool {
+	noRetry, _ := ctx.Value(contextKeyNoRetry).(bool)
+
+	return !noRetry
+}
+
 func customRetryPolicy(ctx context.Context, resp *http.Response, err error) (bool, error) {
-	// do not retry if API token not set
-	if errors.Is(err, ErrAPITokenNoSet) {
+	// do not retry if API token not set or can't be read
+	if errors.Is(err, ErrAPITokenNoSet) || errors.Is(err, ErrAPITokenUnavailable) {
+		return false, err
+	}
+
+	// rate limited requests were not processed, so they are safe to repeat
+	if !isRetryable(ctx) && (resp == nil || resp.StatusCode != http.StatusTooManyRequests) {
 		return false, err
 	}
 
@@ -131,11 +253,24 @@ func NewClient(url string, apiToken string, network string, httpTimeout time.Dur
 	retryableClient := retryablehttp.NewClient()
 	retryableClient.CheckRetry = customRetryPolicy
 	retryableClient.RetryMax = httpRetryMax
-	retryableClient.RequestLogHook = func(logger retryablehttp.Logger, req *http.Request, retryNumber int) {
-		log.Printf("[WARN] Failed to call %s (retry %d)", req.URL.String(), retryNumber)
+	retryableClient.Logger = nil
+	retryableClient.RequestLogHook = func(_ retryablehttp.Logger, req *http.Request, retryNumber int) {
+		if retryNumber > 0 {
+			span := trace.SpanFromContext(req.Context())
+			span.SetAttributes(tracing.RetryCountKey.Int(retryNumber))
+			span.AddEvent("retry")
+
+			tflog.SubsystemWarn(req.Context(), logSubsystemHTTP, "retrying failed request", map[string]interface{}{
+				logFieldMethod: req.Method,
+				logFieldURL:    req.URL.String(),
+				logFieldRetry:  retryNumber,
+			})
+		}
 	}
 	retryableClient.HTTPClient.Timeout = httpTimeout
-	retryableClient.HTTPClient.Transport = newTransport(retryableClient.HTTPClient.Transport, apiToken, version)
+	baseTransport, _ := retryableClient.HTTPClient.Transport.(*http.Transport)
+	auth := newTransport(retryableClient.HTTPClient.Transport, apiToken, version)
+	retryableClient.HTTPClient.Transport = auth
 
 	httpClient := retryableClient.StandardClient()
 
@@ -146,10 +281,12 @@ func NewClient(url string, apiToken string, network string, httpTimeout time.Dur
 		GraphqlClient:    graphql.NewClient(sURL.newGraphqlServerURL(), httpClient),
 		version:          version,
 		pageLimit:        getPageLimit(),
+		MaxConcurrency:   defaultMaxConcurrency,
+		locks:            newKeyedMutex(),
+		transport:        baseTransport,
+		auth:             auth,
 	}
 
-	log.Printf("[INFO] Using Server URL %s", sURL.newGraphqlServerURL())
-
 	return &client
 }
 
@@ -204,7 +341,9 @@ func (client *Client) doRequest(req *http.Request) ([]byte, error) {
 
 	defer func(closer io.Closer) {
 		if err := closer.Close(); err != nil {
-			log.Printf("[ERROR] Error Closing: %s", err)
+			tflog.SubsystemError(req.Context(), logSubsystemHTTP, "failed to close response body", map[string]interface{}{
+				logFieldError: err.Error(),
+			})
 		}
 	}(res.Body)
 
@@ -226,8 +365,15 @@ type MutationResponse interface {
 	ResponseWithPayload
 }
 
-func (client *Client) mutate(ctx context.Context, resp MutationResponse, variables map[string]any, opr operation, attrs ...attr) error {
-	err := client.GraphqlClient.Mutate(ctx, resp, variables, graphql.OperationName(opr.String()))
+func (client *Client) mutate(ctx context.Context, resp MutationResponse, variables map[string]any, opr operation, attrs ...attr) (err error) {
+	ctx, span := startGraphqlSpan(ctx, graphqlMutation, opr, attrs...)
+	defer func() { endGraphqlSpan(span, err) }()
+
+	start := time.Now()
+
+	err = client.GraphqlClient.Mutate(ctx, resp, variables, graphql.OperationName(opr.String()))
+	logGraphqlCall(ctx, opr, start, err, attrs...)
+
 	if err != nil {
 		return opr.apiError(err, attrs...)
 	}
@@ -247,8 +393,17 @@ type ResponseWithPayload interface {
 	IsEmpty() bool
 }
 
-func (client *Client) query(ctx context.Context, resp ResponseWithPayload, variables map[string]any, opr operation, attrs ...attr) error {
-	err := client.GraphqlClient.Query(ctx, resp, variables, graphql.OperationName(opr.String()))
+func (client *Client) query(ctx context.Context, resp ResponseWithPayload, variables map[string]any, opr operation, attrs ...attr) (err error) {
+	ctx, span := startGraphqlSpan(ctx, graphqlQuery, opr, attrs...)
+	defer func() { endGraphqlSpan(span, err) }()
+
+	start := time.Now()
+
+	tracing.CountPage(ctx)
+
+	err = client.GraphqlClient.Query(ctx, resp, variables, graphql.OperationName(opr.String()))
+	logGraphqlCall(ctx, opr, start, err, attrs...)
+
 	if err != nil {
 		return opr.apiError(err, attrs...)
 	}
@@ -4,7 +4,9 @@ import (
 	"context"
 	"errors"
 	"net/http"
+	"net/http/httptest"
 	"os"
+	"sync/atomic"
 	"testing"
 	"time"
 
@@ -107,3 +109,44 @@ func TestClientInvalidServerAddress(t *testing.T) {
 	assert.ErrorContains(t, err, `x509`)
 	assert.ErrorContains(t, err, `certificate`)
 }
+
+func TestCustomRetryPolicyWithoutRetry(t *testing.T) {
+	cases := []struct {
+		ctx      context.Context
+		resp     *http.Response
+		expected bool
+	}{
+		{ctx: context.Background(), resp: &http.Response{StatusCode: http.StatusInternalServerError}, expected: true},
+		{ctx: withoutRetry(context.Background()), resp: &http.Response{StatusCode: http.StatusInternalServerError}, expected: false},
+		{ctx: withoutRetry(context.Background()), resp: nil, expected: false},
+		{ctx: withoutRetry(context.Background()), resp: &http.Response{StatusCode: http.StatusTooManyRequests}, expected: true},
+	}
+
+	for _, c := range cases {
+		retry, _ := customRetryPolicy(c.ctx, c.resp, nil)
+
+		assert.Equal(t, c.expected, retry)
+	}
+}
+
+func TestClientRetriesStopAtContextDeadline(t *testing.T) {
+	var calls int32
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&calls, 1)
+		w.WriteHeader(http.StatusInternalServerError)
+	}))
+	defer server.Close()
+
+	client := NewClient(server.URL, "xxxx", "test", time.Second, 10, "test")
+
+	ctx, cancel := context.WithTimeout(context.Background(), 100*time.Millisecond)
+	defer cancel()
+
+	start := time.Now()
+	_, err := client.post(ctx, "/hello", nil, nil)
+
+	assert.ErrorIs(t, err, context.DeadlineExceeded)
+	assert.Less(t, time.Since(start), time.Second)
+	assert.Equal(t, int32(1), atomic.LoadInt32(&calls))
+}
@@ -0,0 +1,51 @@
+package client
+
+import (
+	"errors"
+	"time"
+)
+
+var ErrNetworkNotSet = errors.New("network not set")
+
+// Config - settings of the client created by New, shared by the provider, the CLI and the sdk.
+type Config struct {
+	// URL - the Twingate domain, e.g. `twingate.com`, or the URL of a specific server.
+	URL      string
+	APIToken string
+	// APITokenFile - reads the API token from the file, it's read again when the API rejects the token.
+	// Takes precedence over APIToken.
+	APITokenFile string
+	// APITokenCommand - uses the output of the command as the API token, takes precedence over APITokenFile.
+	APITokenCommand string
+	HTTPTimeout     time.Duration
+	HTTPMaxRetry    int
+	// HTTPMaxConcurrency - limit of parallel requests made while reading nested pages, the default is used when 0.
+	HTTPMaxConcurrency int
+	Version            string
+	Transport          TransportConfig
+}
+
+// New - creates a client of the given network, e.g. `acme` for `acme.twingate.com`.
+func New(network string, cfg Config) (*Client, error) {
+	if network == "" {
+		return nil, ErrNetworkNotSet
+	}
+
+	c := NewClient(cfg.URL, cfg.APIToken, network, cfg.HTTPTimeout, cfg.HTTPMaxRetry, cfg.Version)
+	if cfg.HTTPMaxConcurrency > 0 {
+		c.MaxConcurrency = cfg.HTTPMaxConcurrency
+	}
+
+	switch {
+	case cfg.APITokenCommand != "":
+		c.SetTokenSource(NewTokenCommandSource(cfg.APITokenCommand))
+	case cfg.APITokenFile != "":
+		c.SetTokenSource(NewTokenFileSource(cfg.APITokenFile))
+	}
+
+	if err := c.ConfigureTransport(cfg.Transport); err != nil {
+		return nil, err
+	}
+
+	return c, nil
+}
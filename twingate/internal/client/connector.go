@@ -77,6 +77,16 @@ func (client *Client) ReadConnector(ctx context.Context, connectorID string) (*m
 }
 
 func (client *Client) ReadConnectors(ctx context.Context) ([]*model.Connector, error) {
+	return collect(ctx, client.VisitConnectors)
+}
+
+// VisitConnectors - calls visit with every connector, page by page, until visit returns false.
+func (client *Client) VisitConnectors(ctx context.Context, visit func(connector *model.Connector) bool) error {
+	return visitPages(ctx, client.ConnectorPages(), visit)
+}
+
+// ConnectorPages - reads all connectors page by page.
+func (client *Client) ConnectorPages() *Pages[*model.Connector] {
 	opr := resourceConnector.read()
 
 	variables := newVars(
@@ -84,16 +94,20 @@ func (client *Client) ReadConnectors(ctx context.Context) ([]*model.Connector, e
 		pageLimit(client.pageLimit),
 	)
 
-	response := query.ReadConnectors{}
-	if err := client.query(ctx, &response, variables, opr.withCustomName("readConnectors"), attr{id: "All"}); err != nil {
-		return nil, err
-	}
+	return newPages(func(ctx context.Context) (*query.PaginatedResource[*query.ConnectorEdge], error) {
+		response := query.ReadConnectors{}
+		if err := client.query(ctx, &response, variables, opr.withCustomName("readConnectors"), attr{id: "All"}); err != nil {
+			return nil, err
+		}
 
-	if err := response.FetchPages(ctx, client.readConnectorsAfter, variables); err != nil {
-		return nil, err //nolint
-	}
+		return &response.PaginatedResource, nil
+	}, client.readConnectorsAfter, variables, convertNodes(func(edge *query.ConnectorEdge) (*model.Connector, bool) {
+		if edge.Node == nil {
+			return nil, false
+		}
 
-	return response.ToModel(), nil
+		return edge.Node.ToModel(), true
+	}))
 }
 
 func (client *Client) readConnectorsAfter(ctx context.Context, variables map[string]interface{}, cursor string) (*query.PaginatedResource[*query.ConnectorEdge], error) {
@@ -0,0 +1,88 @@
+package client
+
+import (
+	"context"
+	"errors"
+	"net"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hasura/go-graphql-client"
+)
+
+// recoverTimeout - time limit of the lookup made by recoverCreate.
+const recoverTimeout = time.Minute
+
+// recoverCreate - create mutations calling it are not retried, since the server may have committed the object before
+// the request failed. On such an ambiguous failure the object is looked up instead, and adopted if exactly one matches.
+// The lookup doesn't depend on ctx being done, since the create may have failed just because of that.
+func recoverCreate[T any](ctx context.Context, createErr error, opr operation, name string, lookup func(ctx context.Context) ([]T, error)) (T, error) {
+	var empty T
+
+	if !isAmbiguousError(createErr) {
+		return empty, createErr
+	}
+
+	lookupCtx, cancel := context.WithTimeout(context.WithoutCancel(ctx), recoverTimeout)
+	defer cancel()
+
+	matches, err := lookup(lookupCtx)
+	if err != nil && !errors.Is(err, ErrGraphqlResultIsEmpty) {
+		tflog.SubsystemWarn(ctx, logSubsystemClient, "failed to look up object after failed create", recoverLogFields(opr, name, err))
+
+		return empty, createErr
+	}
+
+	if len(matches) != 1 {
+		tflog.SubsystemWarn(ctx, logSubsystemClient, "can't recover failed create, the name doesn't match exactly one object",
+			recoverLogFields(opr, name, createErr), map[string]interface{}{"matches": len(matches)})
+
+		return empty, createErr
+	}
+
+	tflog.SubsystemInfo(ctx, logSubsystemClient, "recovered object created by a failed request", recoverLogFields(opr, name, createErr))
+
+	return matches[0], nil
+}
+
+func recoverLogFields(opr operation, name string, err error) map[string]interface{} {
+	fields := opr.logFields(attr{name: name})
+	fields[logFieldError] = err.Error()
+
+	return fields
+}
+
+// isAmbiguousError - reports whether the request might have been processed by the server despite the error,
+// e.g. because of a timeout or a server error. Errors reported by the API itself are definite.
+func isAmbiguousError(err error) bool {
+	var apiErr *APIError
+	if errors.As(err, &apiErr) && apiErr.Class != nil {
+		return false
+	}
+
+	var mutationErr *MutationError
+	if errors.As(err, &mutationErr) || errors.Is(err, context.Canceled) {
+		return false
+	}
+
+	var netErr net.Error
+	if errors.As(err, &netErr) {
+		return true
+	}
+
+	var gqlErrors graphql.Errors
+	if errors.As(err, &gqlErrors) {
+		for _, gqlErr := range gqlErrors {
+			if code, _ := gqlErr.Extensions[extensionCode].(string); code != graphql.ErrRequestError {
+				continue
+			}
+
+			// no status code means the request failed on the transport level
+			if statusCode := leadingStatusCode(gqlErr.Message); statusCode == 0 || statusCode >= 500 { //nolint:gomnd
+				return true
+			}
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,58 @@
+package client
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/Twingate/terraform-provider-twingate/twingate/internal/model"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRecoverCreateLooksUpWhenContextIsDone(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), 0)
+	defer cancel()
+
+	<-ctx.Done()
+
+	network, err := recoverCreate(ctx, ctx.Err(), resourceRemoteNetwork.create(), "office",
+		func(ctx context.Context) ([]*model.RemoteNetwork, error) {
+			assert.NoError(t, ctx.Err())
+
+			_, hasDeadline := ctx.Deadline()
+			assert.True(t, hasDeadline)
+
+			return []*model.RemoteNetwork{{ID: "network-1", Name: "office"}}, nil
+		})
+
+	require.NoError(t, err)
+	assert.Equal(t, "network-1", network.ID)
+}
+
+func TestCreateWithoutRecoveryIsRetried(t *testing.T) {
+	var calls int32
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if atomic.AddInt32(&calls, 1) == 1 {
+			w.Header().Set("Retry-After", "0")
+			w.WriteHeader(http.StatusServiceUnavailable)
+
+			return
+		}
+
+		_, _ = w.Write([]byte(`{"data":{"serviceAccountCreate":{"ok":true,"entity":{"id":"account-1","name":"ci"}}}}`))
+	}))
+	defer server.Close()
+
+	client := NewClient(server.URL, "token", "test", time.Second, 2, "test")
+
+	account, err := client.CreateServiceAccount(context.Background(), "ci")
+
+	require.NoError(t, err)
+	assert.Equal(t, "account-1", account.ID)
+	assert.Equal(t, int32(2), atomic.LoadInt32(&calls))
+}
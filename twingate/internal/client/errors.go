@@ -3,6 +3,9 @@ package client
 import (
 	"errors"
 	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
 
 	"github.com/hasura/go-graphql-client"
 )
@@ -18,6 +21,53 @@ var (
 	ErrGraphqlEmailIsEmpty       = errors.New("email is empty")
 )
 
+// Classes of API errors, use with errors.Is.
+var (
+	ErrNotFound         = errors.New("not found")
+	ErrPermissionDenied = errors.New("permission denied")
+	ErrConflict         = errors.New("conflict")
+	ErrValidation       = errors.New("validation failed")
+)
+
+const (
+	extensionCode     = "code"
+	extensionField    = "field"
+	extensionArgument = "argument"
+)
+
+//nolint:gochecknoglobals
+var errorCodeClasses = map[string]error{
+	"NOT_FOUND":                 ErrNotFound,
+	"FORBIDDEN":                 ErrPermissionDenied,
+	"PERMISSION_DENIED":         ErrPermissionDenied,
+	"UNAUTHORIZED":              ErrPermissionDenied,
+	"UNAUTHENTICATED":           ErrPermissionDenied,
+	"CONFLICT":                  ErrConflict,
+	"ALREADY_EXISTS":            ErrConflict,
+	"DUPLICATE":                 ErrConflict,
+	"BAD_USER_INPUT":            ErrValidation,
+	"BAD_REQUEST":               ErrValidation,
+	"INVALID_ARGUMENT":          ErrValidation,
+	"VALIDATION_ERROR":          ErrValidation,
+	"GRAPHQL_VALIDATION_FAILED": ErrValidation,
+}
+
+// errorMessageClasses - ordered, since e.g. `must be unique` is a conflict rather than a validation error.
+// There is no pattern for ErrNotFound: callers drop objects not found from the state, so it's only reported
+// for an empty result or an explicit NOT_FOUND code, never guessed from a message.
+// Permission patterns are unambiguous phrases only, validation messages like `... is not allowed in alias`
+// must not send the user to fix the API token.
+//
+//nolint:gochecknoglobals
+var errorMessageClasses = []struct {
+	class    error
+	patterns []string
+}{
+	{ErrPermissionDenied, []string{"permission denied", "do not have permission", "not authorized", "unauthorized", "access denied"}},
+	{ErrConflict, []string{"already exists", "already exist", "duplicate", "must be unique", "already in use", "already taken"}},
+	{ErrValidation, []string{"invalid", "must be", "is required", "validation", "not valid", "cannot be", "not allowed"}},
+}
+
 type HTTPError struct {
 	RequestURI string
 	StatusCode int
@@ -42,31 +92,35 @@ type APIError struct {
 	Resource     string
 	ID           graphql.ID
 	Name         string
+	// Class - one of ErrNotFound, ErrPermissionDenied, ErrConflict, ErrValidation, or nil if unknown.
+	Class error
+	// Field - name of the API input field the error refers to, if reported by the API.
+	Field string
 }
 
 func NewAPIErrorWithID(wrappedError error, operation, resource, id string) *APIError {
-	return &APIError{
-		WrappedError: wrappedError,
-		Operation:    operation,
-		Resource:     resource,
-		ID:           graphql.ID(id),
-	}
+	apiErr := NewAPIError(wrappedError, operation, resource)
+	apiErr.ID = graphql.ID(id)
+
+	return apiErr
 }
 
 func NewAPIErrorWithName(wrappedError error, operation, resource, name string) *APIError {
-	return &APIError{
-		WrappedError: wrappedError,
-		Operation:    operation,
-		Resource:     resource,
-		Name:         name,
-	}
+	apiErr := NewAPIError(wrappedError, operation, resource)
+	apiErr.Name = name
+
+	return apiErr
 }
 
 func NewAPIError(wrappedError error, operation, resource string) *APIError {
+	class, field := classifyError(wrappedError)
+
 	return &APIError{
 		WrappedError: wrappedError,
 		Operation:    operation,
 		Resource:     resource,
+		Class:        class,
+		Field:        field,
 	}
 }
 
@@ -101,6 +155,16 @@ func (e *APIError) Unwrap() error {
 	return e.WrappedError
 }
 
+// Is - matches the error class, so that `errors.Is(err, client.ErrNotFound)` works for any API error.
+func (e *APIError) Is(target error) bool {
+	return e.Class != nil && e.Class == target //nolint:errorlint,goerr113
+}
+
+// IsWrite - reports whether the failed operation was meant to modify data.
+func (e *APIError) IsWrite() bool {
+	return e.Operation != operationRead
+}
+
 type MutationError struct {
 	Message string
 }
@@ -114,3 +178,101 @@ func NewMutationError(message string) *MutationError {
 func (e *MutationError) Error() string {
 	return e.Message
 }
+
+// classifyError - detects the class of the error and the API field it refers to.
+func classifyError(err error) (error, string) { //nolint:revive,stylecheck
+	if err == nil {
+		return nil, ""
+	}
+
+	if errors.Is(err, ErrGraphqlResultIsEmpty) {
+		return ErrNotFound, ""
+	}
+
+	var mutationErr *MutationError
+	if errors.As(err, &mutationErr) {
+		return classifyMessage(mutationErr.Message), ""
+	}
+
+	var httpErr *HTTPError
+	if errors.As(err, &httpErr) {
+		return classifyStatusCode(httpErr.StatusCode), ""
+	}
+
+	var gqlErrors graphql.Errors
+	if errors.As(err, &gqlErrors) {
+		for _, gqlErr := range gqlErrors {
+			if class := classifyGraphqlError(gqlErr); class != nil {
+				return class, graphqlErrorField(gqlErr)
+			}
+		}
+	}
+
+	return nil, ""
+}
+
+func classifyGraphqlError(gqlErr graphql.Error) error {
+	code, _ := gqlErr.Extensions[extensionCode].(string)
+
+	if code == graphql.ErrRequestError {
+		// message has the form `403 Forbidden; body: ...` for non 2xx responses
+		return classifyStatusCode(leadingStatusCode(gqlErr.Message))
+	}
+
+	if class, ok := errorCodeClasses[strings.ToUpper(code)]; ok {
+		return class
+	}
+
+	return classifyMessage(gqlErr.Message)
+}
+
+func graphqlErrorField(gqlErr graphql.Error) string {
+	for _, key := range []string{extensionField, extensionArgument} {
+		if field, ok := gqlErr.Extensions[key].(string); ok && field != "" {
+			return field
+		}
+	}
+
+	return ""
+}
+
+func leadingStatusCode(message string) int {
+	end := strings.IndexFunc(message, func(r rune) bool {
+		return r < '0' || r > '9'
+	})
+	if end == -1 {
+		end = len(message)
+	}
+
+	statusCode, _ := strconv.Atoi(message[:end])
+
+	return statusCode
+}
+
+// classifyStatusCode - 404 is not classified, it means a wrong URL or proxy rather than a missing object.
+func classifyStatusCode(statusCode int) error {
+	switch statusCode {
+	case http.StatusUnauthorized, http.StatusForbidden:
+		return ErrPermissionDenied
+	case http.StatusConflict:
+		return ErrConflict
+	case http.StatusBadRequest, http.StatusUnprocessableEntity:
+		return ErrValidation
+	default:
+		return nil
+	}
+}
+
+func classifyMessage(message string) error {
+	message = strings.ToLower(message)
+
+	for _, item := range errorMessageClasses {
+		for _, pattern := range item.patterns {
+			if strings.Contains(message, pattern) {
+				return item.class
+			}
+		}
+	}
+
+	return nil
+}
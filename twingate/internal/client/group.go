@@ -6,6 +6,7 @@ import (
 
 	"github.com/Twingate/terraform-provider-twingate/twingate/internal/client/query"
 	"github.com/Twingate/terraform-provider-twingate/twingate/internal/model"
+	"github.com/Twingate/terraform-provider-twingate/twingate/internal/utils"
 )
 
 func (client *Client) CreateGroup(ctx context.Context, input *model.Group) (*model.Group, error) {
@@ -23,12 +24,20 @@ func (client *Client) CreateGroup(ctx context.Context, input *model.Group) (*mod
 		pageLimit(client.pageLimit),
 	)
 
+	var group *model.Group
+
 	response := query.CreateGroup{}
-	if err := client.mutate(ctx, &response, variables, opr, attr{name: input.Name}); err != nil {
-		return nil, err
+	if err := client.mutate(withoutRetry(ctx), &response, variables, opr, attr{name: input.Name}); err != nil {
+		group, err = recoverCreate(ctx, err, opr, input.Name, func(ctx context.Context) ([]*model.Group, error) {
+			return client.ReadGroups(ctx, &model.GroupsFilter{Name: &input.Name})
+		})
+		if err != nil {
+			return nil, err
+		}
+	} else {
+		group = response.ToModel()
 	}
 
-	group := response.ToModel()
 	group.Users = input.Users
 	group.IsAuthoritative = input.IsAuthoritative
 
@@ -61,14 +70,86 @@ func (client *Client) ReadGroup(ctx context.Context, groupID string) (*model.Gro
 }
 
 func (client *Client) ReadGroups(ctx context.Context, filter *model.GroupsFilter) ([]*model.Group, error) {
+	return collect(ctx, func(ctx context.Context, visit func(group *model.Group) bool) error {
+		return client.VisitGroups(ctx, filter, visit)
+	})
+}
+
+// VisitGroups - calls visit with every group matching the filter, page by page, until visit returns false.
+// Only the first page of users of every group is read.
+func (client *Client) VisitGroups(ctx context.Context, filter *model.GroupsFilter, visit func(group *model.Group) bool) error {
 	opr := resourceGroup.read()
 
-	variables := newVars(
+	variables := client.readGroupsVariables(filter)
+
+	pages := newPages(client.readGroupsFirstPage(opr, variables, filter), client.readGroupsAfter, variables,
+		convertNodes(func(edge *query.GroupEdge) (*model.Group, bool) {
+			if edge.Node == nil {
+				return nil, false
+			}
+
+			return edge.Node.ToModel(), true
+		}))
+
+	return visitPages(ctx, pages, visit)
+}
+
+// FullGroupPages - reads groups matching the filter page by page, with all pages of users of every group.
+func (client *Client) FullGroupPages(filter *model.GroupsFilter) *Pages[*model.Group] {
+	opr := resourceGroup.read()
+
+	variables := client.readGroupsVariables(filter)
+
+	return newPages(client.readGroupsFirstPage(opr, variables, filter), client.readGroupsAfter, variables,
+		func(ctx context.Context, edges []*query.GroupEdge) ([]*model.Group, error) {
+			edges = utils.Filter(edges, func(edge *query.GroupEdge) bool {
+				return edge.Node != nil
+			})
+
+			if err := client.fetchGroupsUsers(ctx, edges); err != nil {
+				return nil, err
+			}
+
+			return utils.Map(edges, func(edge *query.GroupEdge) *model.Group {
+				return edge.Node.ToModel()
+			}), nil
+		})
+}
+
+func (client *Client) readGroupsVariables(filter *model.GroupsFilter) map[string]interface{} {
+	return newVars(
 		gqlNullable(query.NewGroupFilterInput(filter), "filter"),
 		cursor(query.CursorGroups),
 		cursor(query.CursorUsers),
 		pageLimit(client.pageLimit),
 	)
+}
+
+func (client *Client) readGroupsFirstPage(opr operation, variables map[string]interface{}, filter *model.GroupsFilter) func(ctx context.Context) (*query.PaginatedResource[*query.GroupEdge], error) {
+	return func(ctx context.Context) (*query.PaginatedResource[*query.GroupEdge], error) {
+		response := query.ReadGroups{}
+		if err := client.query(ctx, &response, variables, opr.withCustomName("readGroups"),
+			attr{id: "All", name: filter.GetName()}); err != nil {
+			return nil, err
+		}
+
+		return &response.PaginatedResource, nil
+	}
+}
+
+// fetchGroupsUsers - reads the remaining pages of users of every group.
+func (client *Client) fetchGroupsUsers(ctx context.Context, edges []*query.GroupEdge) error {
+	return utils.RunConcurrently(ctx, edges, client.MaxConcurrency, true, func(ctx context.Context, edge *query.GroupEdge) error {
+		return edge.Node.Users.FetchPages(ctx, client.readGroupUsersAfter,
+			newVars(gqlID(edge.Node.ID), cursor(query.CursorUsers), pageLimit(client.pageLimit)))
+	})
+}
+
+// ReadFullGroups - same as ReadGroups, but with all pages of users of every group.
+func (client *Client) ReadFullGroups(ctx context.Context, filter *model.GroupsFilter) ([]*model.Group, error) {
+	opr := resourceGroup.read()
+
+	variables := client.readGroupsVariables(filter)
 
 	response := query.ReadGroups{}
 	if err := client.query(ctx, &response, variables, opr.withCustomName("readGroups"),
@@ -80,6 +161,10 @@ func (client *Client) ReadGroups(ctx context.Context, filter *model.GroupsFilter
 		return nil, err //nolint
 	}
 
+	if err := client.fetchGroupsUsers(ctx, response.Edges); err != nil {
+		return nil, err
+	}
+
 	return response.ToModel(), nil
 }
 
@@ -0,0 +1,54 @@
+package client
+
+import "sync"
+
+// keyedMutex - serializes operations on the same key, while operations on different keys run in parallel.
+type keyedMutex struct {
+	mu    sync.Mutex
+	locks map[string]*keyedLock
+}
+
+type keyedLock struct {
+	sync.Mutex
+	// waiters - number of holders and callers waiting for the lock, it's released from the map once nobody needs it.
+	waiters int
+}
+
+func newKeyedMutex() *keyedMutex {
+	return &keyedMutex{locks: make(map[string]*keyedLock)}
+}
+
+func (m *keyedMutex) lock(key string) (unlock func()) {
+	m.mu.Lock()
+
+	entry, ok := m.locks[key]
+	if !ok {
+		entry = &keyedLock{}
+		m.locks[key] = entry
+	}
+
+	entry.waiters++
+	m.mu.Unlock()
+
+	entry.Lock()
+
+	return func() {
+		entry.Unlock()
+
+		m.mu.Lock()
+		defer m.mu.Unlock()
+
+		entry.waiters--
+		if entry.waiters == 0 {
+			delete(m.locks, key)
+		}
+	}
+}
+
+// LockEntity - serializes read-modify-write sequences on the entity with the given ID,
+// e.g. reading group users, removing the stale ones and updating the group.
+// Operations on other entities are not blocked. The returned function releases the lock.
+// The lock isn't reentrant: the client itself locks service accounts while adding or removing their resources.
+func (client *Client) LockEntity(id string) (unlock func()) {
+	return client.locks.lock(id)
+}
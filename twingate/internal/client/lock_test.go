@@ -0,0 +1,59 @@
+package client
+
+import (
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestKeyedMutexSerializesSameKey(t *testing.T) {
+	locks := newKeyedMutex()
+
+	var (
+		wg      sync.WaitGroup
+		counter int
+	)
+
+	for i := 0; i < 50; i++ {
+		wg.Add(1)
+
+		go func() {
+			defer wg.Done()
+
+			unlock := locks.lock("group-1")
+			defer unlock()
+
+			// read-modify-write without any other synchronization
+			value := counter
+			time.Sleep(time.Microsecond)
+			counter = value + 1
+		}()
+	}
+
+	wg.Wait()
+
+	assert.Equal(t, 50, counter)
+	assert.Empty(t, locks.locks)
+}
+
+func TestKeyedMutexDoesNotBlockOtherKeys(t *testing.T) {
+	locks := newKeyedMutex()
+
+	unlock := locks.lock("group-1")
+	defer unlock()
+
+	done := make(chan struct{})
+
+	go func() {
+		locks.lock("resource-1")()
+		close(done)
+	}()
+
+	select {
+	case <-done:
+	case <-time.After(time.Second):
+		t.Fatal("lock of another key was blocked")
+	}
+}
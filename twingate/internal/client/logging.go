@@ -0,0 +1,123 @@
+package client
+
+import (
+	"context"
+	"os"
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Log subsystems, the level of each one can be set with the TF_LOG_PROVIDER_TWINGATE_<SUBSYSTEM> env var,
+// e.g. TF_LOG_PROVIDER_TWINGATE_HTTP=TRACE also logs request and response bodies.
+const (
+	logSubsystemClient  = "client"
+	logSubsystemGraphQL = "graphql"
+	logSubsystemHTTP    = "http"
+
+	envLogLevel = "TF_LOG_PROVIDER_TWINGATE"
+)
+
+// Fields of log entries.
+const (
+	logFieldOperation    = "operation"
+	logFieldResource     = "resource"
+	logFieldID           = "id"
+	logFieldName         = "name"
+	logFieldDuration     = "duration_ms"
+	logFieldRetry        = "retry"
+	logFieldMethod       = "method"
+	logFieldURL          = "url"
+	logFieldStatus       = "status"
+	logFieldError        = "error"
+	logFieldRequestBody  = "request_body"
+	logFieldResponseBody = "response_body"
+	logFieldAPIKey       = headerAPIKey
+)
+
+// logSecretsRe - tokens in request and response bodies, e.g. connector tokens and service account keys.
+var logSecretsRe = regexp.MustCompile(`(?i)"[a-z_]*(token|apikey|api_key|secret)"\s*:\s*"[^"]*"`) //nolint:gochecknoglobals
+
+// WithLogging - adds the log subsystems of the client to the context, with redaction of secrets.
+// The provider sets them up once for every operation, the client only logs with the context it's given.
+// Without a root logger, e.g. outside of the provider, logging is disabled.
+func WithLogging(ctx context.Context) context.Context {
+	// nil context fails later with a descriptive error of net/http
+	if ctx == nil {
+		return ctx
+	}
+
+	for _, subsystem := range []string{logSubsystemClient, logSubsystemGraphQL, logSubsystemHTTP} {
+		ctx = tflog.NewSubsystem(ctx, subsystem,
+			tflog.WithLevelFromEnv(envLogLevel, subsystem),
+			tflog.WithRootFields(),
+		)
+		ctx = tflog.SubsystemMaskFieldValuesWithFieldKeys(ctx, subsystem, logFieldAPIKey)
+		ctx = tflog.SubsystemMaskAllFieldValuesRegexes(ctx, subsystem, logSecretsRe)
+		ctx = tflog.SubsystemMaskMessageRegexes(ctx, subsystem, logSecretsRe)
+	}
+
+	return ctx
+}
+
+// LogSettings - logs the settings of the client which are worth knowing when reading the provider logs.
+func (client *Client) LogSettings(ctx context.Context) {
+	tflog.SubsystemInfo(ctx, logSubsystemClient, "using server URL", map[string]interface{}{
+		logFieldURL: client.GraphqlServerURL,
+	})
+
+	if client.transport != nil && client.transport.TLSClientConfig != nil && client.transport.TLSClientConfig.InsecureSkipVerify {
+		tflog.SubsystemWarn(ctx, logSubsystemClient, "TLS certificate verification is disabled, connections to the Twingate API are not secure")
+	}
+}
+
+// isBodyLoggingEnabled - bodies are only read for logging when the http subsystem logs at TRACE level.
+func isBodyLoggingEnabled() bool {
+	for _, env := range []string{envLogLevel + "_" + strings.ToUpper(logSubsystemHTTP), "TF_LOG_PROVIDER", "TF_LOG"} {
+		if level := os.Getenv(env); level != "" {
+			return strings.EqualFold(level, "TRACE")
+		}
+	}
+
+	return false
+}
+
+func durationMs(start time.Time) int64 {
+	return time.Since(start).Milliseconds()
+}
+
+// logFields - fields describing the operation and the object it's applied to.
+func (o operation) logFields(attrs ...attr) map[string]interface{} {
+	fields := map[string]interface{}{
+		logFieldOperation: o.String(),
+		logFieldResource:  o.resource,
+	}
+
+	for _, a := range attrs {
+		if a.id != "" {
+			fields[logFieldID] = a.id
+		}
+
+		if a.name != "" {
+			fields[logFieldName] = a.name
+		}
+	}
+
+	return fields
+}
+
+func logGraphqlCall(ctx context.Context, opr operation, start time.Time, err error, attrs ...attr) {
+	fields := opr.logFields(attrs...)
+	fields[logFieldDuration] = durationMs(start)
+
+	if err != nil {
+		fields[logFieldError] = err.Error()
+		tflog.SubsystemDebug(ctx, logSubsystemGraphQL, "graphql operation failed", fields)
+
+		return
+	}
+
+	tflog.SubsystemDebug(ctx, logSubsystemGraphQL, "graphql operation completed", fields)
+}
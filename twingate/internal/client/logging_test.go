@@ -0,0 +1,119 @@
+package client
+
+import (
+	"bytes"
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-log/tflogtest"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func findLogEntry(entries []map[string]interface{}, module, message string) map[string]interface{} {
+	for _, entry := range entries {
+		if entry["@module"] == module && entry["@message"] == message {
+			return entry
+		}
+	}
+
+	return nil
+}
+
+func TestLoggingGraphqlCall(t *testing.T) {
+	t.Setenv("TF_LOG_PROVIDER_TWINGATE_HTTP", "TRACE")
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte(`{"data":{"remoteNetwork":{"id":"network-1","name":"office","location":"OTHER"}},"extensions":{"token":"response-secret"}}`))
+	}))
+	defer server.Close()
+
+	var output bytes.Buffer
+
+	ctx := WithLogging(tflogtest.RootLogger(context.Background(), &output))
+	client := NewClient(server.URL, "api-key-secret", "test", time.Second, 0, "test")
+
+	network, err := client.ReadRemoteNetworkByID(ctx, "network-1")
+	require.NoError(t, err)
+	assert.Equal(t, "office", network.Name)
+
+	assert.NotContains(t, output.String(), "api-key-secret")
+	assert.NotContains(t, output.String(), "response-secret")
+
+	entries, err := tflogtest.MultilineJSONDecode(&output)
+	require.NoError(t, err)
+
+	graphqlEntry := findLogEntry(entries, "provider.graphql", "graphql operation completed")
+	require.NotNil(t, graphqlEntry)
+	assert.Equal(t, "readRemoteNetworkByID", graphqlEntry[logFieldOperation])
+	assert.Equal(t, "network-1", graphqlEntry[logFieldID])
+	assert.Contains(t, graphqlEntry, logFieldDuration)
+
+	httpEntry := findLogEntry(entries, "provider.http", "received response")
+	require.NotNil(t, httpEntry)
+	assert.Equal(t, float64(http.StatusOK), httpEntry[logFieldStatus])
+	assert.Equal(t, http.MethodPost, httpEntry[logFieldMethod])
+
+	requestEntry := findLogEntry(entries, "provider.http", "sending request")
+	require.NotNil(t, requestEntry)
+	assert.Contains(t, requestEntry[logFieldRequestBody], "readRemoteNetworkByID")
+
+	responseEntry := findLogEntry(entries, "provider.http", "response body")
+	require.NotNil(t, responseEntry)
+	assert.Contains(t, responseEntry[logFieldResponseBody], `"name":"office"`)
+}
+
+func TestLoggingWithoutBodies(t *testing.T) {
+	t.Setenv("TF_LOG_PROVIDER_TWINGATE_HTTP", "DEBUG")
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusInternalServerError)
+	}))
+	defer server.Close()
+
+	var output bytes.Buffer
+
+	ctx := WithLogging(tflogtest.RootLogger(context.Background(), &output))
+	client := NewClient(server.URL, "api-key-secret", "test", time.Second, 0, "test")
+
+	_, err := client.ReadRemoteNetworkByID(ctx, "network-1")
+	require.Error(t, err)
+
+	entries, err := tflogtest.MultilineJSONDecode(&output)
+	require.NoError(t, err)
+
+	assert.Nil(t, findLogEntry(entries, "provider.http", "sending request"))
+	assert.NotNil(t, findLogEntry(entries, "provider.http", "received response"))
+
+	graphqlEntry := findLogEntry(entries, "provider.graphql", "graphql operation failed")
+	require.NotNil(t, graphqlEntry)
+	assert.Contains(t, graphqlEntry, logFieldError)
+}
+
+func TestLogSecretsRe(t *testing.T) {
+	body := `{"accessToken":"a","refreshToken":"b","token":"c","name":"office","api_key":"d"}`
+
+	assert.Equal(t, `{***,***,***,"name":"office",***}`, logSecretsRe.ReplaceAllString(body, "***"))
+}
+
+func TestLogSettings(t *testing.T) {
+	var output bytes.Buffer
+
+	ctx := WithLogging(tflogtest.RootLogger(context.Background(), &output))
+
+	client, err := New("test", Config{URL: "http://127.0.0.1:8080", Transport: TransportConfig{InsecureSkipVerify: true}})
+	require.NoError(t, err)
+
+	client.LogSettings(ctx)
+
+	entries, err := tflogtest.MultilineJSONDecode(&output)
+	require.NoError(t, err)
+
+	urlEntry := findLogEntry(entries, "provider.client", "using server URL")
+	require.NotNil(t, urlEntry)
+	assert.Equal(t, "http://127.0.0.1:8080/api/graphql/", urlEntry[logFieldURL])
+	assert.NotNil(t, findLogEntry(entries, "provider.client", "TLS certificate verification is disabled, connections to the Twingate API are not secure"))
+}
@@ -0,0 +1,118 @@
+package client
+
+import (
+	"context"
+
+	"github.com/Twingate/terraform-provider-twingate/twingate/internal/client/query"
+)
+
+// visitFunc - visits items page by page until the visit callback returns false, e.g. Client.VisitConnectors.
+type visitFunc[T any] func(ctx context.Context, visit func(item T) bool) error
+
+// collect - reads all items into memory.
+func collect[T any](ctx context.Context, visitAll visitFunc[T]) ([]T, error) {
+	var items []T
+
+	err := visitAll(ctx, func(item T) bool {
+		items = append(items, item)
+
+		return true
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return items, nil
+}
+
+// CollectMatches - reads items matching the filter, and stops paging once the limit is reached.
+// Zero limit reads all matching items.
+func CollectMatches[T any](ctx context.Context, visitAll visitFunc[T], match func(item T) bool, limit int) ([]T, error) {
+	var items []T
+
+	err := visitAll(ctx, func(item T) bool {
+		if match(item) {
+			items = append(items, item)
+		}
+
+		return limit == 0 || len(items) < limit
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return items, nil
+}
+
+// Pages - reads a list page by page, each call of Next reads a single page with its context,
+// so the list doesn't have to be kept in memory, e.g. Client.RemoteNetworkPages.
+type Pages[T any] struct {
+	next func(ctx context.Context) ([]T, bool, error)
+}
+
+// Next - returns the items of the next page, or false when there are no more pages.
+func (p *Pages[T]) Next(ctx context.Context) ([]T, bool, error) {
+	return p.next(ctx)
+}
+
+// newPages - reads the first page on the first call of Next, and the following ones with fetchNextPage.
+// A nil first page means the list is empty. Convert turns the edges of every page into items, reading their nested pages if needed.
+func newPages[E, T any](readFirstPage func(ctx context.Context) (*query.PaginatedResource[E], error),
+	fetchNextPage query.NextPageFunc[E], variables map[string]interface{},
+	convert func(ctx context.Context, edges []E) ([]T, error)) *Pages[T] {
+	var pager *query.Pager[E]
+
+	return &Pages[T]{next: func(ctx context.Context) ([]T, bool, error) {
+		if pager == nil {
+			page, err := readFirstPage(ctx)
+			if err != nil {
+				return nil, false, err
+			}
+
+			pager = page.Pager(fetchNextPage, variables)
+		}
+
+		edges, ok, err := pager.Next(ctx)
+		if err != nil || !ok {
+			return nil, false, err //nolint:wrapcheck
+		}
+
+		items, err := convert(ctx, edges)
+		if err != nil {
+			return nil, false, err
+		}
+
+		return items, true, nil
+	}}
+}
+
+// convertNodes - converts the nodes of a page, skipping edges without a node.
+func convertNodes[E, T any](node func(edge E) (T, bool)) func(ctx context.Context, edges []E) ([]T, error) {
+	return func(_ context.Context, edges []E) ([]T, error) {
+		items := make([]T, 0, len(edges))
+
+		for _, edge := range edges {
+			if item, ok := node(edge); ok {
+				items = append(items, item)
+			}
+		}
+
+		return items, nil
+	}
+}
+
+// visitPages - calls visit with every item, page by page, until visit returns false.
+func visitPages[T any](ctx context.Context, pages *Pages[T], visit func(item T) bool) error {
+	for {
+		items, ok, err := pages.Next(ctx)
+		if err != nil || !ok {
+			return err
+		}
+
+		for _, item := range items {
+			if !visit(item) {
+				return nil
+			}
+		}
+	}
+}
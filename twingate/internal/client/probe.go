@@ -0,0 +1,129 @@
+package client
+
+import (
+	"bytes"
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"net/url"
+	"strings"
+)
+
+// Failures of Probe, use with errors.Is.
+var (
+	ErrProbeDNS          = errors.New("can't resolve the Twingate API host")
+	ErrProbeTLS          = errors.New("TLS verification of the Twingate API failed")
+	ErrProbeUnauthorized = errors.New("the Twingate API rejected the API token")
+	ErrProbeForbidden    = errors.New("the API token is not allowed to read the network")
+	ErrProbeUnreachable  = errors.New("can't reach the Twingate API")
+)
+
+// probeQuery - the cheapest authenticated query, reading a single ID.
+const probeQuery = `query probe { securityPolicies(first: 1) { edges { node { id } } } }`
+
+// Probe - sends a single authenticated query without retries, to check that the network is reachable
+// and the API token is accepted before any other operation.
+func (client *Client) Probe(ctx context.Context) error {
+	payload, err := json.Marshal(map[string]string{"query": probeQuery})
+	if err != nil {
+		return err //nolint:wrapcheck
+	}
+
+	req, err := http.NewRequestWithContext(withoutRetry(ctx), http.MethodPost, client.GraphqlServerURL, bytes.NewReader(payload))
+	if err != nil {
+		return err //nolint:wrapcheck
+	}
+
+	req.Header.Set("content-type", "application/json")
+
+	resp, err := client.HTTPClient.Do(req)
+	if err != nil {
+		return classifyProbeError(req.URL.Hostname(), err)
+	}
+
+	defer resp.Body.Close()
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return fmt.Errorf("%w: can't read response body: %w", ErrProbeUnreachable, err)
+	}
+
+	switch resp.StatusCode {
+	case http.StatusOK:
+		return probeGraphqlError(body)
+	case http.StatusUnauthorized:
+		return fmt.Errorf("%w: %w", ErrProbeUnauthorized, NewHTTPError(client.GraphqlServerURL, resp.StatusCode, body))
+	case http.StatusForbidden:
+		return fmt.Errorf("%w: %w", ErrProbeForbidden, NewHTTPError(client.GraphqlServerURL, resp.StatusCode, body))
+	default:
+		return fmt.Errorf("%w: %w", ErrProbeUnreachable, NewHTTPError(client.GraphqlServerURL, resp.StatusCode, body))
+	}
+}
+
+func classifyProbeError(host string, err error) error {
+	var (
+		dnsErr          *net.DNSError
+		unknownAuthErr  x509.UnknownAuthorityError
+		hostnameErr     x509.HostnameError
+		certInvalidErr  x509.CertificateInvalidError
+		verificationErr *tls.CertificateVerificationError
+		recordHeaderErr tls.RecordHeaderError
+	)
+
+	switch {
+	case errors.Is(err, ErrAPITokenNoSet), errors.Is(err, ErrAPITokenUnavailable):
+		return err
+	case errors.As(err, &dnsErr):
+		return fmt.Errorf("%w %s: %w", ErrProbeDNS, host, err)
+	case errors.As(err, &unknownAuthErr), errors.As(err, &hostnameErr), errors.As(err, &certInvalidErr),
+		errors.As(err, &verificationErr), errors.As(err, &recordHeaderErr),
+		certNameNotMatchMacErrorRe.MatchString(err.Error()), certNameNotMatchLinuxErrorRe.MatchString(err.Error()):
+		return fmt.Errorf("%w for %s: %w", ErrProbeTLS, host, err)
+	default:
+		return fmt.Errorf("%w at %s: %w", ErrProbeUnreachable, host, unwrapURLError(err))
+	}
+}
+
+func unwrapURLError(err error) error {
+	var urlErr *url.Error
+	if errors.As(err, &urlErr) {
+		return urlErr.Err
+	}
+
+	return err
+}
+
+// probeGraphqlError - GraphQL errors are reported with 200 status, e.g. when the token is not valid for the network.
+func probeGraphqlError(body []byte) error {
+	var response struct {
+		Errors []struct {
+			Message string `json:"message"`
+		} `json:"errors"`
+	}
+
+	if err := json.Unmarshal(body, &response); err != nil {
+		return fmt.Errorf("%w: unexpected response: %w", ErrProbeUnreachable, err)
+	}
+
+	if len(response.Errors) == 0 {
+		return nil
+	}
+
+	messages := make([]string, 0, len(response.Errors))
+	for _, e := range response.Errors {
+		messages = append(messages, e.Message)
+	}
+
+	message := strings.Join(messages, "; ")
+	if errors.Is(classifyMessage(message), ErrPermissionDenied) {
+		return fmt.Errorf("%w: %s", ErrProbeForbidden, message)
+	}
+
+	return fmt.Errorf("%w: %s", ErrProbeUnreachable, message)
+}
@@ -0,0 +1,97 @@
+package client
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func newProbeServer(t *testing.T, status int, body string) *httptest.Server {
+	t.Helper()
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(status)
+		_, _ = w.Write([]byte(body))
+	}))
+	t.Cleanup(server.Close)
+
+	return server
+}
+
+func TestProbe(t *testing.T) {
+	closed := httptest.NewServer(http.NotFoundHandler())
+	closed.Close()
+
+	tlsServer := httptest.NewTLSServer(http.NotFoundHandler())
+	defer tlsServer.Close()
+
+	cases := []struct {
+		url         string
+		expectedErr error
+	}{
+		{
+			url: newProbeServer(t, http.StatusOK, `{"data":{"securityPolicies":{"edges":[]}}}`).URL,
+		},
+		{
+			url:         newProbeServer(t, http.StatusUnauthorized, `{"detail":"invalid token"}`).URL,
+			expectedErr: ErrProbeUnauthorized,
+		},
+		{
+			url:         newProbeServer(t, http.StatusForbidden, `{"detail":"forbidden"}`).URL,
+			expectedErr: ErrProbeForbidden,
+		},
+		{
+			url:         newProbeServer(t, http.StatusOK, `{"errors":[{"message":"Access denied"}]}`).URL,
+			expectedErr: ErrProbeForbidden,
+		},
+		{
+			url:         newProbeServer(t, http.StatusBadGateway, `bad gateway`).URL,
+			expectedErr: ErrProbeUnreachable,
+		},
+		{
+			url:         newProbeServer(t, http.StatusOK, `<html></html>`).URL,
+			expectedErr: ErrProbeUnreachable,
+		},
+		{
+			url:         "http://twingate.invalid",
+			expectedErr: ErrProbeDNS,
+		},
+		{
+			url:         tlsServer.URL,
+			expectedErr: ErrProbeTLS,
+		},
+		{
+			url:         closed.URL,
+			expectedErr: ErrProbeUnreachable,
+		},
+	}
+
+	for n, c := range cases {
+		t.Run(fmt.Sprintf("case_%d", n), func(t *testing.T) {
+			client := NewClient(c.url, "token", "test", time.Second, 3, "test")
+
+			err := client.Probe(context.Background())
+
+			if c.expectedErr == nil {
+				assert.NoError(t, err)
+
+				return
+			}
+
+			assert.ErrorIs(t, err, c.expectedErr)
+		})
+	}
+}
+
+func TestProbeWithoutAPIToken(t *testing.T) {
+	t.Setenv(EnvAPIToken, "")
+
+	client := NewClient(newProbeServer(t, http.StatusOK, `{}`).URL, "", "test", time.Second, 3, "test")
+
+	assert.ErrorIs(t, client.Probe(context.Background()), ErrAPITokenNoSet)
+}
@@ -36,3 +36,64 @@ func (r *PaginatedResource[E]) FetchPages(ctx context.Context, fetchNextPage Nex
 
 	return nil
 }
+
+// VisitPages - calls visit with the edges of this page and then of the following ones, fetching the next page
+// only once the previous one is visited. Stops early when visit returns false or the context is done.
+func (r *PaginatedResource[E]) VisitPages(ctx context.Context, fetchNextPage NextPageFunc[E], variables map[string]interface{}, visit func(edge E) bool) error {
+	pager := r.Pager(fetchNextPage, variables)
+
+	for {
+		edges, ok, err := pager.Next(ctx)
+		if err != nil || !ok {
+			return err
+		}
+
+		for _, edge := range edges {
+			if !visit(edge) {
+				return nil
+			}
+		}
+	}
+}
+
+// Pager - pulls the edges page by page, starting with this page, see VisitPages.
+func (r *PaginatedResource[E]) Pager(fetchNextPage NextPageFunc[E], variables map[string]interface{}) *Pager[E] {
+	return &Pager[E]{page: r, fetchNextPage: fetchNextPage, variables: variables}
+}
+
+type Pager[E any] struct {
+	page          *PaginatedResource[E]
+	fetchNextPage NextPageFunc[E]
+	variables     map[string]interface{}
+	started       bool
+}
+
+// Next - returns the edges of the next page, fetching it with the given context, or false when there are no more pages.
+func (p *Pager[E]) Next(ctx context.Context) ([]E, bool, error) {
+	if p.page == nil {
+		return nil, false, nil
+	}
+
+	if !p.started {
+		p.started = true
+
+		return p.page.Edges, true, nil
+	}
+
+	if !p.page.PageInfo.HasNextPage {
+		return nil, false, nil
+	}
+
+	if err := ctx.Err(); err != nil {
+		return nil, false, err //nolint:wrapcheck
+	}
+
+	next, err := p.fetchNextPage(ctx, p.variables, p.page.PageInfo.EndCursor)
+	if err != nil {
+		return nil, false, err
+	}
+
+	p.page = next
+
+	return next.Edges, true, nil
+}
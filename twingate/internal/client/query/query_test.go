@@ -1,6 +1,7 @@
 package query
 
 import (
+	"context"
 	"errors"
 	"fmt"
 	"testing"
@@ -803,13 +804,37 @@ func TestProtocolToModel(t *testing.T) {
 				Ports: []*PortRange{
 					{Start: 80, End: 80},
 				},
-				Policy: "policy",
+				Policy: model.PolicyRestricted,
 			},
 			expected: &model.Protocol{
 				Ports: []*model.PortRange{
 					{Start: 80, End: 80},
 				},
-				Policy: "policy",
+				Policy: model.PolicyRestricted,
+			},
+		},
+		{
+			protocol: &Protocol{
+				Ports: []*PortRange{
+					{Start: 91, End: 91},
+					{Start: 80, End: 90},
+				},
+				Policy: model.PolicyRestricted,
+			},
+			expected: &model.Protocol{
+				Ports: []*model.PortRange{
+					{Start: 80, End: 91},
+				},
+				Policy: model.PolicyRestricted,
+			},
+		},
+		{
+			protocol: &Protocol{
+				Ports:  []*PortRange{},
+				Policy: model.PolicyRestricted,
+			},
+			expected: &model.Protocol{
+				Policy: model.PolicyDenyAll,
 			},
 		},
 	}
@@ -970,3 +995,83 @@ func TestPortsRangeToModel(t *testing.T) {
 		})
 	}
 }
+
+func TestVisitPages(t *testing.T) {
+	pages := []*PaginatedResource[int]{
+		{PageInfo: PageInfo{EndCursor: "1", HasNextPage: true}, Edges: []int{1, 2}},
+		{PageInfo: PageInfo{EndCursor: "2", HasNextPage: true}, Edges: []int{3, 4}},
+		{PageInfo: PageInfo{EndCursor: "3", HasNextPage: false}, Edges: []int{5}},
+	}
+
+	errFetch := errors.New("fetch failed")
+
+	cases := []struct {
+		stopAt        int
+		fetchErr      error
+		cancel        bool
+		expected      []int
+		expectedPages int
+		expectedErr   error
+	}{
+		{
+			expected:      []int{1, 2, 3, 4, 5},
+			expectedPages: 2,
+		},
+		{
+			stopAt:        2,
+			expected:      []int{1, 2},
+			expectedPages: 0,
+		},
+		{
+			stopAt:        3,
+			expected:      []int{1, 2, 3},
+			expectedPages: 1,
+		},
+		{
+			fetchErr:      errFetch,
+			expected:      []int{1, 2},
+			expectedPages: 1,
+			expectedErr:   errFetch,
+		},
+		{
+			cancel:        true,
+			expected:      []int{1, 2},
+			expectedPages: 0,
+			expectedErr:   context.Canceled,
+		},
+	}
+
+	for n, c := range cases {
+		t.Run(fmt.Sprintf("case_%d", n), func(t *testing.T) {
+			ctx, cancel := context.WithCancel(context.Background())
+			defer cancel()
+
+			if c.cancel {
+				cancel()
+			}
+
+			fetched := 0
+			fetchNextPage := func(ctx context.Context, variables map[string]interface{}, cursor string) (*PaginatedResource[int], error) {
+				fetched++
+
+				if c.fetchErr != nil {
+					return nil, c.fetchErr
+				}
+
+				return pages[fetched], nil
+			}
+
+			var visited []int
+
+			err := pages[0].VisitPages(ctx, fetchNextPage, nil, func(edge int) bool {
+				visited = append(visited, edge)
+
+				return edge != c.stopAt
+			})
+
+			assert.ErrorIs(t, err, c.expectedErr)
+			assert.Equal(t, c.expected, visited)
+			assert.Equal(t, c.expectedPages, fetched)
+		})
+	}
+}
@@ -5,7 +5,7 @@ type ReadRemoteNetworkByName struct {
 }
 
 func (q ReadRemoteNetworkByName) IsEmpty() bool {
-	return len(q.RemoteNetworks.Edges) == 0 || q.RemoteNetworks.Edges[0] == nil
+	return len(q.RemoteNetworks.Edges) == 0 || q.RemoteNetworks.Edges[0] == nil || q.RemoteNetworks.Edges[0].Node == nil
 }
 
 type gqlRemoteNetworks struct {
@@ -20,7 +20,7 @@ type RemoteNetworks struct {
 }
 
 type RemoteNetworkEdge struct {
-	Node gqlRemoteNetwork
+	Node *gqlRemoteNetwork
 }
 
 func (r RemoteNetworks) ToModel() []*model.RemoteNetwork {
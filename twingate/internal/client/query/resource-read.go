@@ -90,10 +90,7 @@ func protocolToModel(protocol *Protocol) *model.Protocol {
 		return nil
 	}
 
-	return &model.Protocol{
-		Ports:  portsRangeToModel(protocol.Ports),
-		Policy: protocol.Policy,
-	}
+	return model.NewProtocol(protocol.Policy, portsRangeToModel(protocol.Ports)).Canonical()
 }
 
 func portsRangeToModel(ports []*PortRange) []*model.PortRange {
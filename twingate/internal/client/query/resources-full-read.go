@@ -0,0 +1,28 @@
+package query
+
+import (
+	"github.com/Twingate/terraform-provider-twingate/twingate/internal/model"
+	"github.com/Twingate/terraform-provider-twingate/twingate/internal/utils"
+)
+
+type ReadFullResources struct {
+	FullResources `graphql:"resources(after: $resourcesEndCursor, first: $pageLimit)"`
+}
+
+func (r ReadFullResources) IsEmpty() bool {
+	return len(r.Edges) == 0
+}
+
+type FullResources struct {
+	PaginatedResource[*FullResourceEdge]
+}
+
+type FullResourceEdge struct {
+	Node *gqlResource
+}
+
+func (r FullResources) ToModel() []*model.Resource {
+	return utils.Map[*FullResourceEdge, *model.Resource](r.Edges, func(edge *FullResourceEdge) *model.Resource {
+		return edge.Node.ToModel()
+	})
+}
@@ -5,6 +5,7 @@ import (
 
 	"github.com/Twingate/terraform-provider-twingate/twingate/internal/client/query"
 	"github.com/Twingate/terraform-provider-twingate/twingate/internal/model"
+	"github.com/Twingate/terraform-provider-twingate/twingate/internal/utils"
 )
 
 type RemoteNetworkLocation string
@@ -23,14 +24,30 @@ func (client *Client) CreateRemoteNetwork(ctx context.Context, req *model.Remote
 	)
 
 	response := query.CreateRemoteNetwork{}
-	if err := client.mutate(ctx, &response, variables, opr, attr{name: req.Name}); err != nil {
-		return nil, err
+	if err := client.mutate(withoutRetry(ctx), &response, variables, opr, attr{name: req.Name}); err != nil {
+		return recoverCreate(ctx, err, opr, req.Name, func(ctx context.Context) ([]*model.RemoteNetwork, error) {
+			networks, err := client.ReadRemoteNetworksByName(ctx, req.Name)
+
+			return utils.Filter(networks, func(network *model.RemoteNetwork) bool {
+				return req.Location == "" || network.Location == req.Location
+			}), err
+		})
 	}
 
 	return response.ToModel(), nil
 }
 
 func (client *Client) ReadRemoteNetworks(ctx context.Context) ([]*model.RemoteNetwork, error) {
+	return collect(ctx, client.VisitRemoteNetworks)
+}
+
+// VisitRemoteNetworks - calls visit with every remote network, page by page, until visit returns false.
+func (client *Client) VisitRemoteNetworks(ctx context.Context, visit func(network *model.RemoteNetwork) bool) error {
+	return visitPages(ctx, client.RemoteNetworkPages(), visit)
+}
+
+// RemoteNetworkPages - reads all remote networks page by page.
+func (client *Client) RemoteNetworkPages() *Pages[*model.RemoteNetwork] {
 	opr := resourceRemoteNetwork.read()
 
 	variables := newVars(
@@ -38,16 +55,27 @@ func (client *Client) ReadRemoteNetworks(ctx context.Context) ([]*model.RemoteNe
 		pageLimit(client.pageLimit),
 	)
 
-	response := query.ReadRemoteNetworks{}
-	if err := client.query(ctx, &response, variables, opr.withCustomName("readRemoteNetworks"), attr{id: "All"}); err != nil {
-		return nil, err
-	}
+	return newPages(func(ctx context.Context) (*query.PaginatedResource[*query.RemoteNetworkEdge], error) {
+		response := query.ReadRemoteNetworks{}
+		if err := client.query(ctx, &response, variables, opr.withCustomName("readRemoteNetworks"), attr{id: "All"}); err != nil {
+			return nil, err
+		}
 
-	if err := response.FetchPages(ctx, client.readRemoteNetworksAfter, variables); err != nil {
-		return nil, err //nolint
-	}
+		return &response.PaginatedResource, nil
+	}, client.readRemoteNetworksAfter, variables, convertNodes(func(edge *query.RemoteNetworkEdge) (*model.RemoteNetwork, bool) {
+		if edge.Node == nil {
+			return nil, false
+		}
 
-	return response.ToModel(), nil
+		return edge.Node.ToModel(), true
+	}))
+}
+
+// ReadRemoteNetworksByName - reads all remote networks with exactly the given name.
+func (client *Client) ReadRemoteNetworksByName(ctx context.Context, remoteNetworkName string) ([]*model.RemoteNetwork, error) {
+	return CollectMatches(ctx, client.VisitRemoteNetworks, func(network *model.RemoteNetwork) bool {
+		return network.Name == remoteNetworkName
+	}, 0)
 }
 
 func (client *Client) readRemoteNetworksAfter(ctx context.Context, variables map[string]interface{}, cursor string) (*query.PaginatedResource[*query.RemoteNetworkEdge], error) {
@@ -44,8 +44,8 @@ func newProtocol(protocol *model.Protocol) *ProtocolInput {
 	}
 
 	return &ProtocolInput{
-		Ports:  newPorts(protocol.Ports),
-		Policy: protocol.Policy,
+		Ports:  newPorts(protocol.NormalizedPorts()),
+		Policy: protocol.APIPolicy(),
 	}
 }
 
@@ -75,12 +75,20 @@ func (client *Client) CreateResource(ctx context.Context, input *model.Resource)
 		pageLimit(client.pageLimit),
 	)
 
+	var resource *model.Resource
+
 	response := query.CreateResource{}
-	if err := client.mutate(ctx, &response, variables, opr); err != nil {
-		return nil, err
+	if err := client.mutate(withoutRetry(ctx), &response, variables, opr); err != nil {
+		resource, err = recoverCreate(ctx, err, opr, input.Name, func(ctx context.Context) ([]*model.Resource, error) {
+			return client.readMatchingResources(ctx, input)
+		})
+		if err != nil {
+			return nil, err
+		}
+	} else {
+		resource = response.Entity.ToModel()
 	}
 
-	resource := response.Entity.ToModel()
 	resource.Groups = input.Groups
 	resource.ServiceAccounts = input.ServiceAccounts
 	resource.IsAuthoritative = input.IsAuthoritative
@@ -96,6 +104,30 @@ func (client *Client) CreateResource(ctx context.Context, input *model.Resource)
 	return resource, nil
 }
 
+// readMatchingResources - reads resources with the same name, address and remote network as the given one.
+func (client *Client) readMatchingResources(ctx context.Context, input *model.Resource) ([]*model.Resource, error) {
+	resources, err := client.ReadResourcesByName(ctx, input.Name)
+	if err != nil {
+		return nil, err
+	}
+
+	address := model.CanonicalAddress(input.Address)
+
+	matches := utils.Filter(resources, func(resource *model.Resource) bool {
+		return resource.RemoteNetworkID == input.RemoteNetworkID && model.CanonicalAddress(resource.Address) == address
+	})
+	if len(matches) != 1 {
+		return matches, nil
+	}
+
+	resource, err := client.ReadResource(ctx, matches[0].ID)
+	if err != nil {
+		return nil, err
+	}
+
+	return []*model.Resource{resource}, nil
+}
+
 func (client *Client) ReadResource(ctx context.Context, resourceID string) (*model.Resource, error) {
 	opr := resourceResource.read()
 
@@ -141,6 +173,11 @@ func (client *Client) readResourceGroupsAfter(ctx context.Context, variables map
 }
 
 func (client *Client) ReadResources(ctx context.Context) ([]*model.Resource, error) {
+	return collect(ctx, client.VisitResources)
+}
+
+// VisitResources - calls visit with every resource, page by page, until visit returns false.
+func (client *Client) VisitResources(ctx context.Context, visit func(resource *model.Resource) bool) error {
 	opr := resourceResource.read()
 
 	variables := newVars(
@@ -148,16 +185,19 @@ func (client *Client) ReadResources(ctx context.Context) ([]*model.Resource, err
 		pageLimit(client.pageLimit),
 	)
 
+	// an empty first page may still be followed by more pages
 	response := query.ReadResources{}
 	if err := client.query(ctx, &response, variables, opr.withCustomName("readResources"), attr{id: "All"}); err != nil && !errors.Is(err, ErrGraphqlResultIsEmpty) {
-		return nil, err
+		return err
 	}
 
-	if err := response.FetchPages(ctx, client.readResourcesAfter, variables); err != nil {
-		return nil, err //nolint
-	}
+	return response.VisitPages(ctx, client.readResourcesAfter, variables, func(edge *query.ResourceEdge) bool { //nolint:wrapcheck
+		if edge.Node == nil {
+			return true
+		}
 
-	return response.ToModel(), nil
+		return visit(edge.Node.ToModel())
+	})
 }
 
 func (client *Client) readResourcesAfter(ctx context.Context, variables map[string]interface{}, cursor string) (*query.PaginatedResource[*query.ResourceEdge], error) {
@@ -173,6 +213,113 @@ func (client *Client) readResourcesAfter(ctx context.Context, variables map[stri
 	return &response.PaginatedResource, nil
 }
 
+// ReadFullResources - reads all resources with their groups and service accounts in bulk.
+// Groups are paginated separately only for resources which have more groups than fit into the first page.
+func (client *Client) ReadFullResources(ctx context.Context) ([]*model.Resource, error) {
+	opr := resourceResource.read()
+
+	variables := newVars(
+		cursor(query.CursorResources),
+		cursor(query.CursorGroups),
+		pageLimit(client.pageLimit),
+	)
+
+	response := query.ReadFullResources{}
+	if err := client.query(ctx, &response, variables, opr.withCustomName("readFullResources"), attr{id: "All"}); err != nil && !errors.Is(err, ErrGraphqlResultIsEmpty) {
+		return nil, err
+	}
+
+	if err := response.FetchPages(ctx, client.readFullResourcesAfter, variables); err != nil {
+		return nil, err //nolint
+	}
+
+	response.Edges = nonEmptyResourceEdges(response.Edges)
+
+	if err := client.fetchResourcesGroups(ctx, response.Edges); err != nil {
+		return nil, err
+	}
+
+	resources := response.ToModel()
+
+	serviceAccounts, err := client.ReadServiceAccounts(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, resource := range resources {
+		for _, account := range serviceAccounts {
+			if utils.Contains(account.Resources, resource.ID) {
+				resource.ServiceAccounts = append(resource.ServiceAccounts, account.ID)
+			}
+		}
+	}
+
+	return resources, nil
+}
+
+// FullResourcePages - reads all resources page by page, with all pages of groups of every resource.
+// Unlike ReadFullResources, service accounts are not set, see ServiceAccountPages.
+func (client *Client) FullResourcePages() *Pages[*model.Resource] {
+	opr := resourceResource.read()
+
+	variables := newVars(
+		cursor(query.CursorResources),
+		cursor(query.CursorGroups),
+		pageLimit(client.pageLimit),
+	)
+
+	return newPages(func(ctx context.Context) (*query.PaginatedResource[*query.FullResourceEdge], error) {
+		response := query.ReadFullResources{}
+		if err := client.query(ctx, &response, variables, opr.withCustomName("readFullResources"), attr{id: "All"}); err != nil {
+			if errors.Is(err, ErrGraphqlResultIsEmpty) {
+				return nil, nil
+			}
+
+			return nil, err
+		}
+
+		return &response.PaginatedResource, nil
+	}, client.readFullResourcesAfter, variables, func(ctx context.Context, edges []*query.FullResourceEdge) ([]*model.Resource, error) {
+		edges = nonEmptyResourceEdges(edges)
+
+		if err := client.fetchResourcesGroups(ctx, edges); err != nil {
+			return nil, err
+		}
+
+		return utils.Map(edges, func(edge *query.FullResourceEdge) *model.Resource {
+			return edge.Node.ToModel()
+		}), nil
+	})
+}
+
+// nonEmptyResourceEdges - the connection may return edges with a null node, e.g. of resources deleted while reading.
+func nonEmptyResourceEdges(edges []*query.FullResourceEdge) []*query.FullResourceEdge {
+	return utils.Filter(edges, func(edge *query.FullResourceEdge) bool {
+		return edge != nil && edge.Node != nil
+	})
+}
+
+// fetchResourcesGroups - reads the remaining pages of groups of every resource.
+func (client *Client) fetchResourcesGroups(ctx context.Context, edges []*query.FullResourceEdge) error {
+	return utils.RunConcurrently(ctx, edges, client.MaxConcurrency, true, func(ctx context.Context, edge *query.FullResourceEdge) error {
+		return edge.Node.Groups.FetchPages(ctx,
+			client.readResourceGroupsAfter, newVars(gqlID(edge.Node.ID), pageLimit(client.pageLimit)))
+	})
+}
+
+func (client *Client) readFullResourcesAfter(ctx context.Context, variables map[string]interface{}, cursor string) (*query.PaginatedResource[*query.FullResourceEdge], error) {
+	opr := resourceResource.read()
+
+	variables[query.CursorResources] = cursor
+
+	response := query.ReadFullResources{}
+	if err := client.query(ctx, &response, variables, opr.withCustomName("readFullResources"), attr{id: "All"}); err != nil {
+		return nil, err
+	}
+
+	return &response.PaginatedResource, nil
+}
+
 func (client *Client) UpdateResource(ctx context.Context, input *model.Resource) (*model.Resource, error) {
 	opr := resourceResource.update()
 
@@ -242,6 +389,13 @@ func (client *Client) UpdateResourceActiveState(ctx context.Context, resource *m
 }
 
 func (client *Client) ReadResourcesByName(ctx context.Context, name string) ([]*model.Resource, error) {
+	return collect(ctx, func(ctx context.Context, visit func(resource *model.Resource) bool) error {
+		return client.VisitResourcesByName(ctx, name, visit)
+	})
+}
+
+// VisitResourcesByName - calls visit with every resource with the given name, page by page, until visit returns false.
+func (client *Client) VisitResourcesByName(ctx context.Context, name string, visit func(resource *model.Resource) bool) error {
 	opr := resourceResource.read()
 
 	variables := newVars(
@@ -252,14 +406,16 @@ func (client *Client) ReadResourcesByName(ctx context.Context, name string) ([]*
 
 	response := query.ReadResourcesByName{}
 	if err := client.query(ctx, &response, variables, opr, attr{id: "All"}); err != nil {
-		return nil, err
+		return err
 	}
 
-	if err := response.FetchPages(ctx, client.readResourcesByNameAfter, variables); err != nil {
-		return nil, err //nolint
-	}
+	return response.VisitPages(ctx, client.readResourcesByNameAfter, variables, func(edge *query.ResourceEdge) bool { //nolint:wrapcheck
+		if edge.Node == nil {
+			return true
+		}
 
-	return response.ToModel(), nil
+		return visit(edge.Node.ToModel())
+	})
 }
 
 func (client *Client) readResourcesByNameAfter(ctx context.Context, variables map[string]interface{}, cursor string) (*query.PaginatedResource[*query.ResourceEdge], error) {
@@ -361,14 +517,22 @@ func (client *Client) ReadResourceServiceAccounts(ctx context.Context, resourceI
 
 func (client *Client) AddResourceServiceAccountIDs(ctx context.Context, resource *model.Resource) error {
 	for _, serviceAccountID := range resource.ServiceAccounts {
-		_, err := client.UpdateServiceAccount(ctx, &model.ServiceAccount{
-			ID:        serviceAccountID,
-			Resources: []string{resource.ID},
-		})
-		if err != nil {
+		if err := client.addServiceAccountResource(ctx, serviceAccountID, resource.ID); err != nil {
 			return err
 		}
 	}
 
 	return nil
 }
+
+func (client *Client) addServiceAccountResource(ctx context.Context, serviceAccountID, resourceID string) error {
+	unlock := client.LockEntity(serviceAccountID)
+	defer unlock()
+
+	_, err := client.UpdateServiceAccount(ctx, &model.ServiceAccount{
+		ID:        serviceAccountID,
+		Resources: []string{resourceID},
+	})
+
+	return err
+}
@@ -31,6 +31,16 @@ func (client *Client) ReadSecurityPolicy(ctx context.Context, securityPolicyID,
 }
 
 func (client *Client) ReadSecurityPolicies(ctx context.Context) ([]*model.SecurityPolicy, error) {
+	return collect(ctx, client.VisitSecurityPolicies)
+}
+
+// VisitSecurityPolicies - calls visit with every security policy, page by page, until visit returns false.
+func (client *Client) VisitSecurityPolicies(ctx context.Context, visit func(policy *model.SecurityPolicy) bool) error {
+	return visitPages(ctx, client.SecurityPolicyPages(), visit)
+}
+
+// SecurityPolicyPages - reads all security policies page by page.
+func (client *Client) SecurityPolicyPages() *Pages[*model.SecurityPolicy] {
 	opr := resourceSecurityPolicy.read()
 
 	variables := newVars(
@@ -38,23 +48,24 @@ func (client *Client) ReadSecurityPolicies(ctx context.Context) ([]*model.Securi
 		pageLimit(client.pageLimit),
 	)
 
-	response := query.ReadSecurityPolicies{}
+	return newPages(func(ctx context.Context) (*query.PaginatedResource[*query.SecurityPolicyEdge], error) {
+		response := query.ReadSecurityPolicies{}
+		if err := client.query(ctx, &response, variables, opr.withCustomName(queryReadSecurityPolicies)); err != nil {
+			if errors.Is(err, ErrGraphqlResultIsEmpty) {
+				return nil, nil
+			}
 
-	err := client.query(ctx, &response, variables, opr.withCustomName(queryReadSecurityPolicies))
-	if err != nil {
-		if errors.Is(err, ErrGraphqlResultIsEmpty) {
-			return nil, nil
+			return nil, err
 		}
 
-		return nil, err
-	}
-
-	err = response.FetchPages(ctx, client.readSecurityPoliciesAfter, variables)
-	if err != nil {
-		return nil, opr.apiError(err)
-	}
+		return &response.PaginatedResource, nil
+	}, client.readSecurityPoliciesAfter, variables, convertNodes(func(edge *query.SecurityPolicyEdge) (*model.SecurityPolicy, bool) {
+		if edge.Node == nil {
+			return nil, false
+		}
 
-	return response.ToModel(), nil
+		return edge.Node.ToModel(), true
+	}))
 }
 
 func (client *Client) readSecurityPoliciesAfter(ctx context.Context, variables map[string]interface{}, cursor string) (*query.PaginatedResource[*query.SecurityPolicyEdge], error) {
@@ -84,6 +84,12 @@ func (client *Client) DeleteServiceAccount(ctx context.Context, serviceAccountID
 }
 
 func (client *Client) ReadShallowServiceAccounts(ctx context.Context) ([]*model.ServiceAccount, error) {
+	return collect(ctx, client.VisitShallowServiceAccounts)
+}
+
+// VisitShallowServiceAccounts - calls visit with every service account without its resources and keys,
+// page by page, until visit returns false.
+func (client *Client) VisitShallowServiceAccounts(ctx context.Context, visit func(serviceAccount *model.ServiceAccount) bool) error {
 	opr := resourceServiceAccount.read()
 
 	variables := newVars(
@@ -93,14 +99,23 @@ func (client *Client) ReadShallowServiceAccounts(ctx context.Context) ([]*model.
 
 	response := query.ReadShallowServiceAccounts{}
 	if err := client.query(ctx, &response, variables, opr, attr{id: "All"}); err != nil {
-		return nil, err
+		return err
 	}
 
-	if err := response.FetchPages(ctx, client.readServiceAccountsAfter, variables); err != nil {
-		return nil, err //nolint
-	}
+	return response.VisitPages(ctx, client.readServiceAccountsAfter, variables, func(edge *query.ServiceAccountEdge) bool { //nolint:wrapcheck
+		if edge.Node == nil {
+			return true
+		}
 
-	return response.ToModel(), nil
+		return visit(edge.Node.ToModel())
+	})
+}
+
+// ReadShallowServiceAccountsByName - reads all service accounts with exactly the given name.
+func (client *Client) ReadShallowServiceAccountsByName(ctx context.Context, serviceAccountName string) ([]*model.ServiceAccount, error) {
+	return CollectMatches(ctx, client.VisitShallowServiceAccounts, func(serviceAccount *model.ServiceAccount) bool {
+		return serviceAccount.Name == serviceAccountName
+	}, 0)
 }
 
 func (client *Client) readServiceAccountsAfter(ctx context.Context, variables map[string]interface{}, cursor string) (*query.PaginatedResource[*query.ServiceAccountEdge], error) {
@@ -145,16 +160,58 @@ func (client *Client) ReadServiceAccounts(ctx context.Context, input ...string)
 		return nil, err //nolint
 	}
 
-	for i := range response.Edges {
-		err := client.fetchServiceInternalResources(ctx, response.Edges[i].Node)
-		if err != nil { // && !errors.Is(err, ErrGraphqlResultIsEmpty) {
-			return nil, err
-		}
+	if err := client.fetchServicesInternalResources(ctx, response.Edges); err != nil {
+		return nil, err
 	}
 
 	return response.Services.ToModel(), nil
 }
 
+// ServiceAccountPages - reads all service accounts page by page, with all their active resources and keys.
+func (client *Client) ServiceAccountPages() *Pages[*model.ServiceAccount] {
+	opr := resourceServiceAccount.read()
+
+	variables := newVars(
+		gqlNullable(query.NewServiceAccountFilterInput(""), "filter"),
+		cursor(query.CursorServices),
+		cursor(query.CursorResources),
+		cursor(query.CursorServiceKeys),
+		pageLimit(client.pageLimit),
+	)
+
+	return newPages(func(ctx context.Context) (*query.PaginatedResource[*query.ServiceEdge], error) {
+		response := query.ReadServiceAccounts{}
+		if err := client.query(ctx, &response, variables, opr.withCustomName(queryReadServiceAccounts), attr{id: "All"}); err != nil {
+			if errors.Is(err, ErrGraphqlResultIsEmpty) {
+				return nil, nil
+			}
+
+			return nil, err
+		}
+
+		return &response.PaginatedResource, nil
+	}, client.readServicesAfter, variables, func(ctx context.Context, edges []*query.ServiceEdge) ([]*model.ServiceAccount, error) {
+		edges = utils.Filter(edges, func(edge *query.ServiceEdge) bool {
+			return edge.Node != nil
+		})
+
+		if err := client.fetchServicesInternalResources(ctx, edges); err != nil {
+			return nil, err
+		}
+
+		return utils.Map(edges, func(edge *query.ServiceEdge) *model.ServiceAccount {
+			return edge.Node.ToModel()
+		}), nil
+	})
+}
+
+// fetchServicesInternalResources - reads the remaining pages of resources and keys of every service account.
+func (client *Client) fetchServicesInternalResources(ctx context.Context, edges []*query.ServiceEdge) error {
+	return utils.RunConcurrently(ctx, edges, client.MaxConcurrency, true, func(ctx context.Context, edge *query.ServiceEdge) error {
+		return client.fetchServiceInternalResources(ctx, edge.Node)
+	})
+}
+
 func (client *Client) readServicesAfter(ctx context.Context, variables map[string]interface{}, cursor string) (*query.PaginatedResource[*query.ServiceEdge], error) {
 	opr := resourceServiceAccount.read()
 
@@ -253,6 +310,9 @@ func (client *Client) UpdateServiceAccountRemoveResources(ctx context.Context, s
 		return opr.apiError(ErrGraphqlIDIsEmpty)
 	}
 
+	unlock := client.LockEntity(serviceAccountID)
+	defer unlock()
+
 	_, err := client.ReadShallowServiceAccount(ctx, serviceAccountID)
 	if errors.Is(err, ErrGraphqlResultIsEmpty) {
 		// no-op - service does not exist
@@ -0,0 +1,135 @@
+package client
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"os/exec"
+	"runtime"
+	"strings"
+	"sync"
+)
+
+var (
+	ErrAPITokenUnavailable = errors.New("api token unavailable")
+	ErrAPITokenEmpty       = errors.New("api token is empty")
+)
+
+// TokenSource - provides the API token sent with every request.
+type TokenSource interface {
+	Token(ctx context.Context) (string, error)
+	// Invalidate - called when the API rejected the token with 401, the next Token call should return a fresh one.
+	// It returns false when the source can't provide another token.
+	Invalidate(token string) bool
+}
+
+// staticToken - the token of the provider configuration, with a fallback to the TWINGATE_API_TOKEN env var.
+type staticToken string
+
+func (t staticToken) Token(_ context.Context) (string, error) {
+	token := string(t)
+	if token == "" {
+		token = os.Getenv(EnvAPIToken)
+	}
+
+	if token == "" {
+		return "", ErrAPITokenNoSet
+	}
+
+	return token, nil
+}
+
+func (t staticToken) Invalidate(_ string) bool {
+	return false
+}
+
+// cachedToken - keeps the fetched token until the API rejects it.
+type cachedToken struct {
+	mu    sync.Mutex
+	token string
+	fetch func(ctx context.Context) (string, error)
+}
+
+func (t *cachedToken) Token(ctx context.Context) (string, error) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	if t.token != "" {
+		return t.token, nil
+	}
+
+	token, err := t.fetch(ctx)
+	if err != nil {
+		return "", fmt.Errorf("%w: %w", ErrAPITokenUnavailable, err)
+	}
+
+	token = strings.TrimSpace(token)
+	if token == "" {
+		return "", fmt.Errorf("%w: %w", ErrAPITokenUnavailable, ErrAPITokenEmpty)
+	}
+
+	t.token = token
+
+	return token, nil
+}
+
+func (t *cachedToken) Invalidate(token string) bool {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	// requests running in parallel may be rejected with the same token, only the first one drops it
+	if t.token == token {
+		t.token = ""
+	}
+
+	return true
+}
+
+// NewTokenFileSource - reads the API token from the file, it's read again after the API rejected the token.
+func NewTokenFileSource(path string) TokenSource {
+	return &cachedToken{
+		fetch: func(_ context.Context) (string, error) {
+			data, err := os.ReadFile(path) //#nosec G304 -- path is provider configuration
+			if err != nil {
+				return "", fmt.Errorf("failed to read api token file: %w", err)
+			}
+
+			return string(data), nil
+		},
+	}
+}
+
+// NewTokenCommandSource - runs the command with the system shell and uses its output as the API token.
+// The command is run again after the API rejected the token, e.g. to get a new short-lived token from a secrets broker.
+func NewTokenCommandSource(command string) TokenSource {
+	return &cachedToken{
+		fetch: func(ctx context.Context) (string, error) {
+			cmd := shellCommand(ctx, command)
+
+			var stdout, stderr bytes.Buffer
+			cmd.Stdout = &stdout
+			cmd.Stderr = &stderr
+
+			if err := cmd.Run(); err != nil {
+				return "", fmt.Errorf("api token command failed: %w: %s", err, strings.TrimSpace(stderr.String()))
+			}
+
+			return stdout.String(), nil
+		},
+	}
+}
+
+func shellCommand(ctx context.Context, command string) *exec.Cmd {
+	if runtime.GOOS == "windows" {
+		return exec.CommandContext(ctx, "cmd", "/C", command) //#nosec G204 -- command is provider configuration
+	}
+
+	return exec.CommandContext(ctx, "sh", "-c", command) //#nosec G204 -- command is provider configuration
+}
+
+// SetTokenSource - replaces the source of the API token, it must be called before the client is used.
+func (client *Client) SetTokenSource(source TokenSource) {
+	client.auth.tokens = source
+}
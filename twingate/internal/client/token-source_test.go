@@ -0,0 +1,146 @@
+package client
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// tokenServer - accepts only the current token and echoes the request body.
+type tokenServer struct {
+	*httptest.Server
+	mu    sync.Mutex
+	token string
+	calls int32
+}
+
+func newTokenServer(t *testing.T, token string) *tokenServer {
+	t.Helper()
+
+	server := &tokenServer{token: token}
+	server.Server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&server.calls, 1)
+
+		server.mu.Lock()
+		valid := r.Header.Get(headerAPIKey) == server.token
+		server.mu.Unlock()
+
+		if !valid {
+			w.WriteHeader(http.StatusUnauthorized)
+
+			return
+		}
+
+		body, _ := io.ReadAll(r.Body)
+		_, _ = w.Write(body)
+	}))
+	t.Cleanup(server.Close)
+
+	return server
+}
+
+func (s *tokenServer) rotate(token string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.token = token
+}
+
+func writeToken(t *testing.T, path, token string) {
+	t.Helper()
+
+	require.NoError(t, os.WriteFile(path, []byte(token+"\n"), 0o600))
+}
+
+func TestTokenFileSourceRefreshesOnUnauthorized(t *testing.T) {
+	server := newTokenServer(t, "token-1")
+	path := filepath.Join(t.TempDir(), "token")
+	writeToken(t, path, "token-1")
+
+	client := NewClient(server.URL, "", "test", time.Second, 0, "test")
+	client.SetTokenSource(NewTokenFileSource(path))
+
+	body, err := client.post(context.Background(), "/hello", "first", nil)
+	assert.NoError(t, err)
+	assert.Equal(t, `"first"`, string(body))
+
+	server.rotate("token-2")
+	writeToken(t, path, "token-2")
+
+	body, err = client.post(context.Background(), "/hello", "second", nil)
+	assert.NoError(t, err)
+	assert.Equal(t, `"second"`, string(body))
+	assert.Equal(t, int32(3), atomic.LoadInt32(&server.calls))
+}
+
+func TestTokenCommandSourceIsCached(t *testing.T) {
+	server := newTokenServer(t, "token-1")
+	dir := t.TempDir()
+	path := filepath.Join(dir, "token")
+	runs := filepath.Join(dir, "runs")
+	writeToken(t, path, "token-1")
+
+	client := NewClient(server.URL, "", "test", time.Second, 0, "test")
+	client.SetTokenSource(NewTokenCommandSource(fmt.Sprintf("echo run >> %s && cat %s", runs, path)))
+
+	for i := 0; i < 3; i++ {
+		_, err := client.post(context.Background(), "/hello", nil, nil)
+		assert.NoError(t, err)
+	}
+
+	server.rotate("token-2")
+	writeToken(t, path, "token-2")
+
+	_, err := client.post(context.Background(), "/hello", nil, nil)
+	assert.NoError(t, err)
+
+	output, err := os.ReadFile(runs)
+	require.NoError(t, err)
+	assert.Equal(t, "run\nrun\n", string(output))
+}
+
+func TestStaticTokenIsNotRefreshed(t *testing.T) {
+	server := newTokenServer(t, "token-1")
+
+	client := NewClient(server.URL, "token-2", "test", time.Second, 0, "test")
+
+	_, err := client.post(context.Background(), "/hello", nil, nil)
+	assert.ErrorContains(t, err, "status 401")
+	assert.Equal(t, int32(1), atomic.LoadInt32(&server.calls))
+}
+
+func TestTokenSourceErrors(t *testing.T) {
+	cases := []struct {
+		source      TokenSource
+		expectedErr string
+	}{
+		{source: NewTokenFileSource("/not/existing/token"), expectedErr: "failed to read api token file"},
+		{source: NewTokenCommandSource("echo denied >&2; exit 3"), expectedErr: "api token command failed: exit status 3: denied"},
+		{source: NewTokenCommandSource("echo"), expectedErr: ErrAPITokenEmpty.Error()},
+	}
+
+	for n, c := range cases {
+		t.Run(fmt.Sprintf("case_%d", n), func(t *testing.T) {
+			server := newTokenServer(t, "token-1")
+
+			client := NewClient(server.URL, "", "test", time.Second, 2, "test")
+			client.SetTokenSource(c.source)
+
+			_, err := client.post(context.Background(), "/hello", nil, nil)
+			assert.ErrorIs(t, err, ErrAPITokenUnavailable)
+			assert.ErrorContains(t, err, c.expectedErr)
+			assert.Equal(t, int32(0), atomic.LoadInt32(&server.calls))
+		})
+	}
+}
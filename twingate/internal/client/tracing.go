@@ -0,0 +1,51 @@
+package client
+
+import (
+	"context"
+
+	"github.com/Twingate/terraform-provider-twingate/twingate/internal/tracing"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// Types of GraphQL operations, as in the graphql.operation.type attribute of OpenTelemetry semantic conventions.
+const (
+	graphqlQuery    = "query"
+	graphqlMutation = "mutation"
+)
+
+const (
+	graphqlOperationTypeKey = attribute.Key("graphql.operation.type")
+	graphqlOperationNameKey = attribute.Key("graphql.operation.name")
+)
+
+// startGraphqlSpan - starts the span of a single GraphQL call, a child of the span of the CRUD function if there is one.
+// Retries of the call are counted on this span.
+func startGraphqlSpan(ctx context.Context, operationType string, opr operation, attrs ...attr) (context.Context, trace.Span) {
+	spanAttrs := []attribute.KeyValue{
+		graphqlOperationTypeKey.String(operationType),
+		graphqlOperationNameKey.String(opr.String()),
+		tracing.EntityKey.String(opr.resource),
+	}
+
+	for _, a := range attrs {
+		if a.id != "" {
+			spanAttrs = append(spanAttrs, tracing.IDKey.String(a.id))
+		}
+	}
+
+	return tracing.Tracer().Start(ctx, opr.String(),
+		trace.WithSpanKind(trace.SpanKindClient),
+		trace.WithAttributes(spanAttrs...),
+	)
+}
+
+func endGraphqlSpan(span trace.Span, err error) {
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+	}
+
+	span.End()
+}
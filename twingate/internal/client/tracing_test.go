@@ -0,0 +1,131 @@
+package client
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/Twingate/terraform-provider-twingate/twingate/internal/tracing"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+)
+
+func newInMemoryExporter(t *testing.T) *tracetest.InMemoryExporter {
+	t.Helper()
+
+	exporter := tracetest.NewInMemoryExporter()
+	previous := otel.GetTracerProvider()
+
+	otel.SetTracerProvider(sdktrace.NewTracerProvider(sdktrace.WithSyncer(exporter)))
+	t.Cleanup(func() {
+		otel.SetTracerProvider(previous)
+	})
+
+	return exporter
+}
+
+func findSpan(spans tracetest.SpanStubs, name string) *tracetest.SpanStub {
+	for i := range spans {
+		if spans[i].Name == name {
+			return &spans[i]
+		}
+	}
+
+	return nil
+}
+
+func spanAttribute(span *tracetest.SpanStub, key attribute.Key) attribute.Value {
+	for _, kv := range span.Attributes {
+		if kv.Key == key {
+			return kv.Value
+		}
+	}
+
+	return attribute.Value{}
+}
+
+func TestTracingGraphqlCall(t *testing.T) {
+	exporter := newInMemoryExporter(t)
+
+	var calls int32
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if atomic.AddInt32(&calls, 1) == 1 {
+			w.Header().Set("Retry-After", "0")
+			w.WriteHeader(http.StatusServiceUnavailable)
+
+			return
+		}
+
+		_, _ = w.Write([]byte(`{"data":{"remoteNetwork":{"id":"network-1","name":"office","location":"OTHER"}}}`))
+	}))
+	defer server.Close()
+
+	client := NewClient(server.URL, "token", "test", time.Second, 2, "test")
+
+	res := tracing.Resource("twingate_remote_network", &schema.Resource{
+		Schema: map[string]*schema.Schema{},
+		ReadContext: func(ctx context.Context, resourceData *schema.ResourceData, meta interface{}) diag.Diagnostics {
+			network, err := meta.(*Client).ReadRemoteNetworkByID(ctx, resourceData.Id())
+			if err != nil {
+				return diag.FromErr(err)
+			}
+
+			resourceData.SetId(network.ID)
+
+			return nil
+		},
+	})
+
+	resourceData := schema.TestResourceDataRaw(t, res.Schema, map[string]interface{}{})
+	resourceData.SetId("network-1")
+
+	require.False(t, res.ReadContext(context.Background(), resourceData, client).HasError())
+
+	spans := exporter.GetSpans()
+
+	crudSpan := findSpan(spans, "read twingate_remote_network")
+	require.NotNil(t, crudSpan)
+	assert.Equal(t, attribute.Int64Value(1), spanAttribute(crudSpan, tracing.PageCountKey))
+	assert.Equal(t, attribute.StringValue("network-1"), spanAttribute(crudSpan, tracing.IDKey))
+
+	graphqlSpan := findSpan(spans, "readRemoteNetworkByID")
+	require.NotNil(t, graphqlSpan)
+	assert.Equal(t, crudSpan.SpanContext.SpanID(), graphqlSpan.Parent.SpanID())
+	assert.Equal(t, codes.Unset, graphqlSpan.Status.Code)
+	assert.Equal(t, attribute.StringValue(graphqlQuery), spanAttribute(graphqlSpan, graphqlOperationTypeKey))
+	assert.Equal(t, attribute.StringValue(string(resourceRemoteNetwork)), spanAttribute(graphqlSpan, tracing.EntityKey))
+	assert.Equal(t, attribute.StringValue("network-1"), spanAttribute(graphqlSpan, tracing.IDKey))
+	assert.Equal(t, attribute.IntValue(1), spanAttribute(graphqlSpan, tracing.RetryCountKey))
+}
+
+func TestTracingFailedMutation(t *testing.T) {
+	exporter := newInMemoryExporter(t)
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte(`{"data":{"remoteNetworkDelete":{"ok":false,"error":"not allowed"}}}`))
+	}))
+	defer server.Close()
+
+	client := NewClient(server.URL, "token", "test", time.Second, 0, "test")
+
+	err := client.DeleteRemoteNetwork(context.Background(), "network-1")
+	require.Error(t, err)
+
+	graphqlSpan := findSpan(exporter.GetSpans(), "deleteRemoteNetwork")
+	require.NotNil(t, graphqlSpan)
+	assert.Equal(t, attribute.StringValue(graphqlMutation), spanAttribute(graphqlSpan, graphqlOperationTypeKey))
+	assert.Equal(t, codes.Error, graphqlSpan.Status.Code)
+	assert.Equal(t, err.Error(), graphqlSpan.Status.Description)
+	assert.Equal(t, attribute.Value{}, spanAttribute(graphqlSpan, tracing.RetryCountKey))
+}
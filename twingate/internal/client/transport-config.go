@@ -0,0 +1,157 @@
+package client
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"os"
+)
+
+var (
+	ErrInvalidProxyURL       = errors.New("invalid proxy url")
+	ErrInvalidCACert         = errors.New("no valid PEM certificates found in the CA bundle")
+	ErrClientCertKeyMismatch = errors.New("client certificate and client key must be set together")
+	ErrTransportNotSupported = errors.New("http transport can't be configured")
+)
+
+// TransportConfig - proxy and TLS settings of the HTTP transport. The zero value keeps the defaults:
+// the proxy is taken from the HTTP_PROXY, HTTPS_PROXY and NO_PROXY environment variables
+// and the server certificate is verified against the system roots.
+type TransportConfig struct {
+	// ProxyURL - proxy used for all requests, e.g. `http://proxy.internal:3128`.
+	ProxyURL string
+	// CACertFile, CACertPEM - PEM encoded certificates trusted in addition to the system roots,
+	// e.g. the CA of a TLS intercepting proxy.
+	CACertFile string
+	CACertPEM  string
+	// InsecureSkipVerify - disables verification of the server certificate, only meant for debugging.
+	InsecureSkipVerify bool
+	// ClientCertFile, ClientKeyFile, ClientCertPEM, ClientKeyPEM - PEM encoded certificate and key
+	// presented to servers requiring mutual TLS.
+	ClientCertFile string
+	ClientKeyFile  string
+	ClientCertPEM  string
+	ClientKeyPEM   string
+}
+
+// ConfigureTransport - applies proxy and TLS settings to the transport used by all requests of the client.
+// It must be called before the client is used.
+func (client *Client) ConfigureTransport(cfg TransportConfig) error {
+	if client.transport == nil {
+		return ErrTransportNotSupported
+	}
+
+	if cfg.ProxyURL != "" {
+		proxy, err := parseProxyURL(cfg.ProxyURL)
+		if err != nil {
+			return err
+		}
+
+		client.transport.Proxy = http.ProxyURL(proxy)
+	}
+
+	tlsConfig, err := newTLSConfig(cfg)
+	if err != nil {
+		return err
+	}
+
+	if tlsConfig != nil {
+		client.transport.TLSClientConfig = tlsConfig
+	}
+
+	return nil
+}
+
+func parseProxyURL(proxyURL string) (*url.URL, error) {
+	proxy, err := url.Parse(proxyURL)
+	if err != nil {
+		return nil, fmt.Errorf("%w %q: %w", ErrInvalidProxyURL, proxyURL, err)
+	}
+
+	switch proxy.Scheme {
+	case "http", "https", "socks5":
+	default:
+		return nil, fmt.Errorf("%w %q: scheme must be http, https or socks5", ErrInvalidProxyURL, proxyURL)
+	}
+
+	if proxy.Host == "" {
+		return nil, fmt.Errorf("%w %q: host is missing", ErrInvalidProxyURL, proxyURL)
+	}
+
+	return proxy, nil
+}
+
+// newTLSConfig - returns nil when the default TLS settings should be kept.
+func newTLSConfig(cfg TransportConfig) (*tls.Config, error) {
+	caCert, err := readPEM(cfg.CACertFile, cfg.CACertPEM)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read CA certificate: %w", err)
+	}
+
+	clientCert, err := readPEM(cfg.ClientCertFile, cfg.ClientCertPEM)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read client certificate: %w", err)
+	}
+
+	clientKey, err := readPEM(cfg.ClientKeyFile, cfg.ClientKeyPEM)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read client key: %w", err)
+	}
+
+	if len(caCert) == 0 && len(clientCert) == 0 && len(clientKey) == 0 && !cfg.InsecureSkipVerify {
+		return nil, nil //nolint:nilnil
+	}
+
+	tlsConfig := &tls.Config{
+		MinVersion: tls.VersionTLS12,
+	}
+
+	if len(caCert) > 0 {
+		pool, err := x509.SystemCertPool()
+		if err != nil {
+			pool = x509.NewCertPool()
+		}
+
+		if !pool.AppendCertsFromPEM(caCert) {
+			return nil, ErrInvalidCACert
+		}
+
+		tlsConfig.RootCAs = pool
+	}
+
+	if len(clientCert) > 0 || len(clientKey) > 0 {
+		if len(clientCert) == 0 || len(clientKey) == 0 {
+			return nil, ErrClientCertKeyMismatch
+		}
+
+		cert, err := tls.X509KeyPair(clientCert, clientKey)
+		if err != nil {
+			return nil, fmt.Errorf("invalid client certificate: %w", err)
+		}
+
+		tlsConfig.Certificates = []tls.Certificate{cert}
+	}
+
+	if cfg.InsecureSkipVerify {
+		tlsConfig.InsecureSkipVerify = true //#nosec G402 -- explicitly requested by the user
+	}
+
+	return tlsConfig, nil
+}
+
+// readPEM - returns the content of the file, or the inline PEM when the file is not set.
+func readPEM(file, pem string) ([]byte, error) {
+	if file == "" {
+		return []byte(pem), nil
+	}
+
+	data, err := os.ReadFile(file) //#nosec G304 -- path is provider configuration
+	if err != nil {
+		return nil, err //nolint:wrapcheck
+	}
+
+	return data, nil
+}
@@ -0,0 +1,190 @@
+package client
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"fmt"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func newTLSTestServer(t *testing.T, clientAuth tls.ClientAuthType) *httptest.Server {
+	t.Helper()
+
+	server := httptest.NewUnstartedServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if clientAuth != tls.NoClientCert && len(r.TLS.PeerCertificates) == 0 {
+			w.WriteHeader(http.StatusUnauthorized)
+
+			return
+		}
+
+		_, _ = w.Write([]byte(`{}`))
+	}))
+	server.TLS = &tls.Config{ClientAuth: clientAuth, MinVersion: tls.VersionTLS12}
+	server.StartTLS()
+	t.Cleanup(server.Close)
+
+	return server
+}
+
+func serverCertPEM(server *httptest.Server) string {
+	return string(pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: server.Certificate().Raw}))
+}
+
+func newClientCertPEM(t *testing.T) (string, string) {
+	t.Helper()
+
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+
+	template := &x509.Certificate{
+		SerialNumber: big.NewInt(1),
+		Subject:      pkix.Name{CommonName: "terraform"},
+		NotBefore:    time.Now().Add(-time.Hour),
+		NotAfter:     time.Now().Add(time.Hour),
+		ExtKeyUsage:  []x509.ExtKeyUsage{x509.ExtKeyUsageClientAuth},
+	}
+
+	cert, err := x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+	require.NoError(t, err)
+
+	keyDER, err := x509.MarshalECPrivateKey(key)
+	require.NoError(t, err)
+
+	return string(pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: cert})),
+		string(pem.EncodeToMemory(&pem.Block{Type: "EC PRIVATE KEY", Bytes: keyDER}))
+}
+
+func newTLSTestClient(server *httptest.Server) *Client {
+	return NewClient(server.URL, "xxxx", "test", time.Second, 0, "test")
+}
+
+func TestConfigureTransportTLS(t *testing.T) {
+	server := newTLSTestServer(t, tls.NoClientCert)
+
+	caFile := filepath.Join(t.TempDir(), "ca.pem")
+	require.NoError(t, os.WriteFile(caFile, []byte(serverCertPEM(server)), 0o600))
+
+	cases := []struct {
+		cfg         TransportConfig
+		expectedErr string
+	}{
+		{
+			cfg:         TransportConfig{},
+			expectedErr: "certificate",
+		},
+		{
+			cfg: TransportConfig{CACertPEM: serverCertPEM(server)},
+		},
+		{
+			cfg: TransportConfig{CACertFile: caFile},
+		},
+		{
+			cfg: TransportConfig{InsecureSkipVerify: true},
+		},
+	}
+
+	for n, c := range cases {
+		t.Run(fmt.Sprintf("case_%d", n), func(t *testing.T) {
+			client := newTLSTestClient(server)
+			require.NoError(t, client.ConfigureTransport(c.cfg))
+
+			_, err := client.post(context.Background(), "/hello", nil, nil)
+
+			if c.expectedErr != "" {
+				assert.ErrorContains(t, err, c.expectedErr)
+
+				return
+			}
+
+			assert.NoError(t, err)
+		})
+	}
+}
+
+func TestConfigureTransportClientCertificate(t *testing.T) {
+	server := newTLSTestServer(t, tls.RequireAnyClientCert)
+	certPEM, keyPEM := newClientCertPEM(t)
+
+	dir := t.TempDir()
+	certFile := filepath.Join(dir, "client.pem")
+	keyFile := filepath.Join(dir, "client.key")
+
+	require.NoError(t, os.WriteFile(certFile, []byte(certPEM), 0o600))
+	require.NoError(t, os.WriteFile(keyFile, []byte(keyPEM), 0o600))
+
+	cases := []TransportConfig{
+		{CACertPEM: serverCertPEM(server), ClientCertPEM: certPEM, ClientKeyPEM: keyPEM},
+		{CACertPEM: serverCertPEM(server), ClientCertFile: certFile, ClientKeyFile: keyFile},
+	}
+
+	for n, cfg := range cases {
+		t.Run(fmt.Sprintf("case_%d", n), func(t *testing.T) {
+			client := newTLSTestClient(server)
+			require.NoError(t, client.ConfigureTransport(cfg))
+
+			_, err := client.post(context.Background(), "/hello", nil, nil)
+			assert.NoError(t, err)
+		})
+	}
+}
+
+func TestConfigureTransportProxy(t *testing.T) {
+	client := newTestClient()
+	require.NoError(t, client.ConfigureTransport(TransportConfig{ProxyURL: "http://proxy.internal:3128"}))
+
+	req, err := http.NewRequest(http.MethodGet, client.GraphqlServerURL, nil)
+	require.NoError(t, err)
+
+	proxy, err := client.transport.Proxy(req)
+	assert.NoError(t, err)
+	assert.Equal(t, &url.URL{Scheme: "http", Host: "proxy.internal:3128"}, proxy)
+}
+
+func TestConfigureTransportErrors(t *testing.T) {
+	certPEM, keyPEM := newClientCertPEM(t)
+
+	cases := []struct {
+		cfg         TransportConfig
+		expectedErr error
+		errContains string
+	}{
+		{cfg: TransportConfig{ProxyURL: "proxy.internal:3128"}, expectedErr: ErrInvalidProxyURL},
+		{cfg: TransportConfig{ProxyURL: "ftp://proxy.internal"}, expectedErr: ErrInvalidProxyURL},
+		{cfg: TransportConfig{ProxyURL: "http://"}, expectedErr: ErrInvalidProxyURL},
+		{cfg: TransportConfig{CACertPEM: "not a certificate"}, expectedErr: ErrInvalidCACert},
+		{cfg: TransportConfig{CACertFile: "/not/existing/ca.pem"}, errContains: "failed to read CA certificate"},
+		{cfg: TransportConfig{ClientCertPEM: certPEM}, expectedErr: ErrClientCertKeyMismatch},
+		{cfg: TransportConfig{ClientKeyPEM: keyPEM}, expectedErr: ErrClientCertKeyMismatch},
+		{cfg: TransportConfig{ClientCertPEM: certPEM, ClientKeyPEM: certPEM}, errContains: "invalid client certificate"},
+	}
+
+	for n, c := range cases {
+		t.Run(fmt.Sprintf("case_%d", n), func(t *testing.T) {
+			err := newTestClient().ConfigureTransport(c.cfg)
+
+			if c.expectedErr != nil {
+				assert.ErrorIs(t, err, c.expectedErr)
+			}
+
+			if c.errContains != "" {
+				assert.ErrorContains(t, err, c.errContains)
+			}
+		})
+	}
+}
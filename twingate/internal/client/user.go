@@ -9,6 +9,16 @@ import (
 )
 
 func (client *Client) ReadUsers(ctx context.Context) ([]*model.User, error) {
+	return collect(ctx, client.VisitUsers)
+}
+
+// VisitUsers - calls visit with every user, page by page, until visit returns false.
+func (client *Client) VisitUsers(ctx context.Context, visit func(user *model.User) bool) error {
+	return visitPages(ctx, client.UserPages(), visit)
+}
+
+// UserPages - reads all users page by page.
+func (client *Client) UserPages() *Pages[*model.User] {
 	opr := resourceUser.read()
 
 	variables := newVars(
@@ -16,20 +26,24 @@ func (client *Client) ReadUsers(ctx context.Context) ([]*model.User, error) {
 		pageLimit(client.pageLimit),
 	)
 
-	response := query.ReadUsers{}
-	if err := client.query(ctx, &response, variables, opr.withCustomName("readUsers"), attr{id: "All"}); err != nil {
-		if errors.Is(err, ErrGraphqlResultIsEmpty) {
-			return nil, nil
-		}
+	return newPages(func(ctx context.Context) (*query.PaginatedResource[*query.UserEdge], error) {
+		response := query.ReadUsers{}
+		if err := client.query(ctx, &response, variables, opr.withCustomName("readUsers"), attr{id: "All"}); err != nil {
+			if errors.Is(err, ErrGraphqlResultIsEmpty) {
+				return nil, nil
+			}
 
-		return nil, err
-	}
+			return nil, err
+		}
 
-	if err := response.FetchPages(ctx, client.readUsersAfter, variables); err != nil {
-		return nil, err //nolint
-	}
+		return &response.PaginatedResource, nil
+	}, client.readUsersAfter, variables, convertNodes(func(edge *query.UserEdge) (*model.User, bool) {
+		if edge.Node == nil {
+			return nil, false
+		}
 
-	return response.ToModel(), nil
+		return edge.Node.ToModel(), true
+	}))
 }
 
 func (client *Client) readUsersAfter(ctx context.Context, variables map[string]interface{}, cursor string) (*query.PaginatedResource[*query.UserEdge], error) {
@@ -0,0 +1,148 @@
+package model
+
+import (
+	"net/netip"
+	"strings"
+)
+
+const (
+	AddressTypeIP      = "IP"
+	AddressTypeCIDR    = "CIDR"
+	AddressTypeFQDN    = "FQDN"
+	AddressTypeDNSZone = "DNS_ZONE"
+
+	cidrSeparator     = "/"
+	labelSeparator    = "."
+	ipv6Separator     = ":"
+	ipv4Labels        = 4
+	digits            = "0123456789"
+	wildcardChars     = "*?"
+	maxFQDNLength     = 253
+	maxDNSLabelLength = 63
+)
+
+// Address - classified and canonicalized Resource address.
+type Address struct {
+	Type      string
+	Value     string
+	Canonical string
+}
+
+// ParseAddress - classifies the given Resource address as IP, CIDR, FQDN or wildcard DNS zone.
+// Returns an error for malformed addresses, including CIDRs with host bits set.
+func ParseAddress(str string) (*Address, error) {
+	if str == "" || strings.TrimSpace(str) != str {
+		return nil, NewInvalidAddressError(str, "address must be a non-empty string without surrounding whitespace")
+	}
+
+	if strings.Contains(str, cidrSeparator) {
+		return parseCIDR(str)
+	}
+
+	if ip, err := netip.ParseAddr(str); err == nil {
+		return &Address{Type: AddressTypeIP, Value: str, Canonical: ip.String()}, nil
+	}
+
+	return parseDNSName(str)
+}
+
+// CanonicalAddress - returns canonical form of the address, or the input as is if it can't be parsed.
+func CanonicalAddress(str string) string {
+	address, err := ParseAddress(str)
+	if err != nil {
+		return str
+	}
+
+	return address.Canonical
+}
+
+func parseCIDR(str string) (*Address, error) {
+	prefix, err := netip.ParsePrefix(str)
+	if err != nil {
+		return nil, NewInvalidAddressError(str, "invalid CIDR notation")
+	}
+
+	if masked := prefix.Masked(); masked != prefix {
+		return nil, NewInvalidAddressError(str, "CIDR has host bits set, did you mean `"+masked.String()+"`?")
+	}
+
+	return &Address{Type: AddressTypeCIDR, Value: str, Canonical: prefix.String()}, nil
+}
+
+func parseDNSName(str string) (*Address, error) {
+	name := strings.TrimSuffix(str, labelSeparator)
+	if looksLikeIP(name) {
+		return nil, NewInvalidAddressError(str, "invalid IP address")
+	}
+
+	if len(name) > maxFQDNLength {
+		return nil, NewInvalidAddressError(str, "DNS name is longer than 253 characters")
+	}
+
+	addressType := AddressTypeFQDN
+
+	for _, label := range strings.Split(name, labelSeparator) {
+		isWildcard, err := validateDNSLabel(label)
+		if err != nil {
+			return nil, NewInvalidAddressError(str, err.Error())
+		}
+
+		if isWildcard {
+			addressType = AddressTypeDNSZone
+		}
+	}
+
+	return &Address{Type: addressType, Value: str, Canonical: strings.ToLower(name)}, nil
+}
+
+func validateDNSLabel(label string) (bool, error) {
+	if label == "" {
+		return false, ErrEmptyDNSLabel
+	}
+
+	if len(label) > maxDNSLabelLength {
+		return false, ErrDNSLabelTooLong
+	}
+
+	if strings.HasPrefix(label, "-") || strings.HasSuffix(label, "-") {
+		return false, ErrDNSLabelHyphen
+	}
+
+	var isWildcard bool
+
+	for _, char := range label {
+		switch {
+		case strings.ContainsRune(wildcardChars, char):
+			isWildcard = true
+		case char >= 'a' && char <= 'z',
+			char >= 'A' && char <= 'Z',
+			char >= '0' && char <= '9',
+			char == '-', char == '_':
+		default:
+			return false, NewInvalidDNSCharacterError(char)
+		}
+	}
+
+	return isWildcard, nil
+}
+
+// looksLikeIP - all-numeric dotted quads (e.g. `10.0.0.256`) and names with colons (e.g. `2001:db8::g`) are mistyped IPs
+// rather than FQDNs, while other all-numeric names like `123` are valid host names.
+func looksLikeIP(name string) bool {
+	if strings.Contains(name, ipv6Separator) {
+		return true
+	}
+
+	labels := strings.Split(name, labelSeparator)
+	if len(labels) != ipv4Labels {
+		return false
+	}
+
+	for _, label := range labels {
+		if label == "" || strings.Trim(label, digits) != "" {
+			return false
+		}
+	}
+
+	return true
+}
@@ -3,9 +3,15 @@ package model
 import (
 	"errors"
 	"fmt"
+	"strings"
 )
 
-var ErrInvalidPortRangeLen = errors.New("port range expects 2 values")
+var (
+	ErrInvalidPortRangeLen = errors.New("port range expects 2 values")
+	ErrEmptyDNSLabel       = errors.New("DNS name contains an empty label")
+	ErrDNSLabelTooLong     = errors.New("DNS label is longer than 63 characters")
+	ErrDNSLabelHyphen      = errors.New("DNS label can't start or end with a hyphen")
+)
 
 func ErrInvalidPortRange(portRange string, err error) error {
 	return fmt.Errorf(`failed to parse protocols port range "%s": %w`, portRange, err)
@@ -40,3 +46,35 @@ func NewPortRangeNotRisingSequenceError(start, end int) *PortRangeNotRisingSeque
 func (e *PortRangeNotRisingSequenceError) Error() string {
 	return fmt.Sprintf("ports %d, %d needs to be in a rising sequence", e.Start, e.End)
 }
+
+type InvalidAddressError struct {
+	Address string
+	Reason  string
+}
+
+func NewInvalidAddressError(address, reason string) *InvalidAddressError {
+	return &InvalidAddressError{
+		Address: address,
+		Reason:  reason,
+	}
+}
+
+func (e *InvalidAddressError) Error() string {
+	return fmt.Sprintf(`invalid address "%s": %s`, e.Address, e.Reason)
+}
+
+func NewInvalidDNSCharacterError(char rune) error {
+	return fmt.Errorf("DNS name contains invalid character %q", char) //nolint:goerr113
+}
+
+func NewUnknownServiceError(name string) error {
+	return fmt.Errorf("unknown service %q, supported services: %s", name, strings.Join(ServiceNames(), ", ")) //nolint:goerr113
+}
+
+func NewServiceNotSupportedError(name, transport string) error {
+	return fmt.Errorf("service %q has no %s ports", name, strings.ToUpper(transport)) //nolint:goerr113
+}
+
+func NewServicesPolicyError(policy string) error {
+	return fmt.Errorf("services can only be used with the %s policy, got %s", PolicyRestricted, policy) //nolint:goerr113
+}
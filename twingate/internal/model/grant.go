@@ -0,0 +1,73 @@
+package model
+
+import (
+	"time"
+
+	"github.com/Twingate/terraform-provider-twingate/twingate/internal/attr"
+	"github.com/Twingate/terraform-provider-twingate/twingate/internal/utils"
+)
+
+const (
+	GrantTypeGroup          = "group"
+	GrantTypeServiceAccount = "service_account"
+)
+
+// Grant - time-bound access of a group or service account to a resource.
+type Grant struct {
+	Type      string
+	ID        string
+	ExpiresAt string
+}
+
+// IsExpired - grants with unparsable expiration time are treated as expired.
+func (g *Grant) IsExpired(now time.Time) bool {
+	expiresAt, err := time.Parse(time.RFC3339, g.ExpiresAt)
+	if err != nil {
+		return true
+	}
+
+	return !now.Before(expiresAt)
+}
+
+func (g *Grant) idAttribute() string {
+	if g.Type == GrantTypeServiceAccount {
+		return attr.ServiceAccountID
+	}
+
+	return attr.GroupID
+}
+
+func (g *Grant) ToTerraform() interface{} {
+	return map[string]interface{}{
+		g.idAttribute(): g.ID,
+		attr.ExpiresAt:  g.ExpiresAt,
+	}
+}
+
+func (g *Grant) ActiveGrantToTerraform() interface{} {
+	return map[string]interface{}{
+		attr.Type:      g.Type,
+		attr.ID:        g.ID,
+		attr.ExpiresAt: g.ExpiresAt,
+	}
+}
+
+// ActiveGrantIDs - IDs of grants with the given type which are not expired yet.
+func ActiveGrantIDs(grants []*Grant, grantType string, now time.Time) []string {
+	return grantIDs(grants, func(grant *Grant) bool {
+		return grant.Type == grantType && !grant.IsExpired(now)
+	})
+}
+
+// GrantIDs - IDs of all grants with the given type.
+func GrantIDs(grants []*Grant, grantType string) []string {
+	return grantIDs(grants, func(grant *Grant) bool {
+		return grant.Type == grantType
+	})
+}
+
+func grantIDs(grants []*Grant, ok func(grant *Grant) bool) []string {
+	return utils.Map[*Grant, string](utils.Filter[*Grant](grants, ok), func(grant *Grant) string {
+		return grant.ID
+	})
+}
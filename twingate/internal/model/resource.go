@@ -2,7 +2,10 @@ package model
 
 import (
 	"fmt"
+	"reflect"
+	"sort"
 	"strings"
+	"time"
 
 	"github.com/Twingate/terraform-provider-twingate/twingate/internal/attr"
 	"github.com/Twingate/terraform-provider-twingate/twingate/internal/utils"
@@ -33,6 +36,7 @@ type Resource struct {
 	IsVisible                *bool
 	IsBrowserShortcutEnabled *bool
 	Alias                    *string
+	Grants                   []*Grant
 }
 
 func (r Resource) AccessToTerraform() []interface{} {
@@ -45,6 +49,14 @@ func (r Resource) AccessToTerraform() []interface{} {
 		rawMap[attr.ServiceAccountIDs] = r.ServiceAccounts
 	}
 
+	if grants := r.grantsToTerraform(GrantTypeGroup); len(grants) != 0 {
+		rawMap[attr.GroupGrant] = grants
+	}
+
+	if grants := r.grantsToTerraform(GrantTypeServiceAccount); len(grants) != 0 {
+		rawMap[attr.ServiceAccountGrant] = grants
+	}
+
 	if len(rawMap) == 0 {
 		return nil
 	}
@@ -52,6 +64,62 @@ func (r Resource) AccessToTerraform() []interface{} {
 	return []interface{}{rawMap}
 }
 
+func (r Resource) grantsToTerraform(grantType string) []interface{} {
+	grants := utils.Filter[*Grant](r.Grants, func(grant *Grant) bool {
+		return grant.Type == grantType
+	})
+
+	return utils.Map[*Grant, interface{}](grants, func(grant *Grant) interface{} {
+		return grant.ToTerraform()
+	})
+}
+
+// ActiveGrantsToTerraform - grants which are not expired and bound to the resource.
+func (r Resource) ActiveGrantsToTerraform(now time.Time) []interface{} {
+	grants := utils.Filter[*Grant](r.Grants, func(grant *Grant) bool {
+		return !grant.IsExpired(now)
+	})
+
+	return utils.Map[*Grant, interface{}](grants, func(grant *Grant) interface{} {
+		return grant.ActiveGrantToTerraform()
+	})
+}
+
+// ReconcileGrants - splits groups and service accounts bound to the resource into permanent IDs and grants.
+// Expired grants which are still bound are kept in the permanent IDs to be reported as drift,
+// active grants which are not bound anymore are dropped to be bound again on the next apply.
+func (r *Resource) ReconcileGrants(grants []*Grant, now time.Time) {
+	bound := map[string]map[string]bool{
+		GrantTypeGroup:          utils.MakeLookupMap(r.Groups),
+		GrantTypeServiceAccount: utils.MakeLookupMap(r.ServiceAccounts),
+	}
+	covered := map[string]map[string]bool{
+		GrantTypeGroup:          {},
+		GrantTypeServiceAccount: {},
+	}
+
+	r.Grants = utils.Filter[*Grant](grants, func(grant *Grant) bool {
+		if grant.IsExpired(now) {
+			return true
+		}
+
+		if bound[grant.Type][grant.ID] {
+			covered[grant.Type][grant.ID] = true
+
+			return true
+		}
+
+		return false
+	})
+
+	r.Groups = utils.Filter[string](r.Groups, func(id string) bool {
+		return !covered[GrantTypeGroup][id]
+	})
+	r.ServiceAccounts = utils.Filter[string](r.ServiceAccounts, func(id string) bool {
+		return !covered[GrantTypeServiceAccount][id]
+	})
+}
+
 func (r Resource) GetID() string {
 	return r.ID
 }
@@ -138,8 +206,10 @@ func newPortRange(str string) (*PortRange, error) {
 }
 
 type Protocol struct {
-	Ports  []*PortRange
-	Policy string
+	Ports        []*PortRange
+	Policy       string
+	Services     []string
+	ServicePorts []*PortRange
 }
 
 func (p *Protocol) PortsToString() []string {
@@ -152,17 +222,119 @@ func (p *Protocol) PortsToString() []string {
 	})
 }
 
+// NewProtocol - keeps the policy as the user wrote it, ports are only kept for the `RESTRICTED` policy.
 func NewProtocol(policy string, ports []*PortRange) *Protocol {
 	switch policy {
-	case PolicyAllowAll:
-		return &Protocol{Policy: PolicyAllowAll}
-	case PolicyDenyAll:
-		return &Protocol{Policy: PolicyRestricted}
+	case PolicyAllowAll, PolicyDenyAll:
+		return &Protocol{Policy: policy}
 	default:
 		return &Protocol{Policy: policy, Ports: ports}
 	}
 }
 
+// EffectivePolicy - `RESTRICTED` without ports denies all traffic, so it's reported as `DENY_ALL`.
+func (p *Protocol) EffectivePolicy() string {
+	if p.Policy == PolicyDenyAll || p.Policy == PolicyRestricted && len(p.Ports) == 0 && len(p.ServicePorts) == 0 {
+		return PolicyDenyAll
+	}
+
+	return p.Policy
+}
+
+// APIPolicy - the API has no `DENY_ALL` policy, it's expressed as `RESTRICTED` without ports.
+func (p *Protocol) APIPolicy() string {
+	if p.Policy == PolicyDenyAll {
+		return PolicyRestricted
+	}
+
+	return p.Policy
+}
+
+// NormalizedPorts - sorted port ranges, including ports of service presets,
+// with overlapping, adjacent and duplicate ranges merged.
+func (p *Protocol) NormalizedPorts() []*PortRange {
+	if p.EffectivePolicy() != PolicyRestricted {
+		return nil
+	}
+
+	ports := make([]*PortRange, 0, len(p.Ports)+len(p.ServicePorts))
+	ports = append(ports, p.Ports...)
+	ports = append(ports, p.ServicePorts...)
+
+	return NormalizePorts(ports)
+}
+
+// Canonical - returns protocol with effective policy and normalized ports.
+func (p *Protocol) Canonical() *Protocol {
+	if p == nil {
+		return nil
+	}
+
+	return &Protocol{
+		Policy: p.EffectivePolicy(),
+		Ports:  p.NormalizedPorts(),
+	}
+}
+
+// Equal - checks whether both protocols grant the same access.
+func (p *Protocol) Equal(other *Protocol) bool {
+	if p == nil || other == nil {
+		return p == other
+	}
+
+	return reflect.DeepEqual(p.Canonical(), other.Canonical())
+}
+
+// WithIntent - returns the intent if it grants the same access as p,
+// this way the user's policy and ports notation are preserved in the state.
+func (p *Protocol) WithIntent(intent *Protocol) *Protocol {
+	if intent != nil && p.Equal(intent) {
+		return intent
+	}
+
+	return p
+}
+
+// NormalizePorts - sorts port ranges, merges overlapping and adjacent ranges and removes duplicates.
+func NormalizePorts(ports []*PortRange) []*PortRange {
+	ranges := utils.Filter[*PortRange](ports, func(port *PortRange) bool {
+		return port != nil
+	})
+
+	if len(ranges) == 0 {
+		return nil
+	}
+
+	sorted := utils.Map[*PortRange, *PortRange](ranges, func(port *PortRange) *PortRange {
+		return &PortRange{Start: port.Start, End: port.End}
+	})
+
+	sort.Slice(sorted, func(i, j int) bool {
+		if sorted[i].Start == sorted[j].Start {
+			return sorted[i].End < sorted[j].End
+		}
+
+		return sorted[i].Start < sorted[j].Start
+	})
+
+	result := []*PortRange{sorted[0]}
+
+	for _, port := range sorted[1:] {
+		last := result[len(result)-1]
+		if port.Start > last.End+1 {
+			result = append(result, port)
+
+			continue
+		}
+
+		if port.End > last.End {
+			last.End = port.End
+		}
+	}
+
+	return result
+}
+
 func DefaultProtocol() *Protocol {
 	return &Protocol{
 		Policy: PolicyAllowAll,
@@ -183,6 +355,28 @@ func DefaultProtocols() *Protocols {
 	}
 }
 
+// Equal - checks whether both protocols grant the same access.
+func (p *Protocols) Equal(other *Protocols) bool {
+	if p == nil || other == nil {
+		return p == other
+	}
+
+	return p.AllowIcmp == other.AllowIcmp && p.TCP.Equal(other.TCP) && p.UDP.Equal(other.UDP)
+}
+
+// WithIntent - keeps the user's notation of TCP and UDP protocols when they grant the same access.
+func (p *Protocols) WithIntent(intent *Protocols) *Protocols {
+	if p == nil || intent == nil {
+		return p
+	}
+
+	return &Protocols{
+		UDP:       p.UDP.WithIntent(intent.UDP),
+		TCP:       p.TCP.WithIntent(intent.TCP),
+		AllowIcmp: p.AllowIcmp,
+	}
+}
+
 func (p *Protocols) ToTerraform() []interface{} {
 	if p == nil {
 		return nil
@@ -207,10 +401,14 @@ func (p *Protocol) ToTerraform() []interface{} {
 		return nil
 	}
 
-	return []interface{}{
-		map[string]interface{}{
-			attr.Policy: p.Policy,
-			attr.Ports:  p.PortsToString(),
-		},
+	rawMap := map[string]interface{}{
+		attr.Policy: p.Policy,
+		attr.Ports:  p.PortsToString(),
+	}
+
+	if len(p.Services) != 0 {
+		rawMap[attr.Services] = p.Services
 	}
+
+	return []interface{}{rawMap}
 }
@@ -7,6 +7,14 @@ type SecurityPolicy struct {
 	Name string
 }
 
+func (s SecurityPolicy) GetID() string {
+	return s.ID
+}
+
+func (s SecurityPolicy) GetName() string {
+	return s.Name
+}
+
 func (s SecurityPolicy) ToTerraform() interface{} {
 	return map[string]interface{}{
 		attr.ID:   s.ID,
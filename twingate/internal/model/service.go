@@ -0,0 +1,88 @@
+package model
+
+import (
+	"sort"
+)
+
+const (
+	TransportTCP = "tcp"
+	TransportUDP = "udp"
+)
+
+// Service - named preset of TCP and UDP ports.
+type Service struct {
+	Name string
+	TCP  []*PortRange
+	UDP  []*PortRange
+}
+
+func (s Service) ports(transport string) []*PortRange {
+	if transport == TransportUDP {
+		return s.UDP
+	}
+
+	return s.TCP
+}
+
+func ports(values ...int) []*PortRange {
+	result := make([]*PortRange, 0, len(values))
+	for _, port := range values {
+		result = append(result, &PortRange{Start: port, End: port})
+	}
+
+	return result
+}
+
+//nolint:gochecknoglobals,gomnd
+var services = map[string]Service{
+	"dns":            {Name: "dns", TCP: ports(53), UDP: ports(53)},
+	"http":           {Name: "http", TCP: ports(80)},
+	"https":          {Name: "https", TCP: ports(443), UDP: ports(443)},
+	"kubernetes-api": {Name: "kubernetes-api", TCP: ports(6443)},
+	"ldap":           {Name: "ldap", TCP: ports(389), UDP: ports(389)},
+	"ldaps":          {Name: "ldaps", TCP: ports(636)},
+	"mongodb":        {Name: "mongodb", TCP: []*PortRange{{Start: 27017, End: 27019}}},
+	"mysql":          {Name: "mysql", TCP: ports(3306)},
+	"postgres":       {Name: "postgres", TCP: ports(5432)},
+	"rdp":            {Name: "rdp", TCP: ports(3389), UDP: ports(3389)},
+	"redis":          {Name: "redis", TCP: ports(6379)},
+	"smb":            {Name: "smb", TCP: ports(445)},
+	"ssh":            {Name: "ssh", TCP: ports(22)},
+	"vnc":            {Name: "vnc", TCP: ports(5900)},
+	"winrm":          {Name: "winrm", TCP: []*PortRange{{Start: 5985, End: 5986}}},
+}
+
+// ServiceNames - sorted names of the built-in service presets.
+func ServiceNames() []string {
+	names := make([]string, 0, len(services))
+	for name := range services {
+		names = append(names, name)
+	}
+
+	sort.Strings(names)
+
+	return names
+}
+
+// ServicePorts - expands service presets into port ranges for the given transport (`tcp` or `udp`).
+func ServicePorts(transport string, names []string) ([]*PortRange, error) {
+	var result []*PortRange
+
+	for _, name := range names {
+		service, ok := services[name]
+		if !ok {
+			return nil, NewUnknownServiceError(name)
+		}
+
+		servicePorts := service.ports(transport)
+		if len(servicePorts) == 0 {
+			return nil, NewServiceNotSupportedError(name, transport)
+		}
+
+		for _, port := range servicePorts {
+			result = append(result, &PortRange{Start: port.Start, End: port.End})
+		}
+	}
+
+	return result, nil
+}
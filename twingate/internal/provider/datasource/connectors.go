@@ -13,12 +13,12 @@ import (
 func datasourceConnectorsRead(ctx context.Context, resourceData *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	c := meta.(*client.Client)
 
-	connectors, err := c.ReadConnectors(ctx)
+	connectors, err := visitToTerraform(ctx, c.VisitConnectors)
 	if err != nil && !errors.Is(err, client.ErrGraphqlResultIsEmpty) {
 		return diag.FromErr(err)
 	}
 
-	if err := resourceData.Set(attr.Connectors, convertConnectorsToTerraform(connectors)); err != nil {
+	if err := resourceData.Set(attr.Connectors, connectors); err != nil {
 		return diag.FromErr(err)
 	}
 
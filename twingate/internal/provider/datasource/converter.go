@@ -1,9 +1,28 @@
 package datasource
 
 import (
+	"context"
+
 	"github.com/Twingate/terraform-provider-twingate/twingate/internal/model"
 )
 
+type terraformEntity interface {
+	ToTerraform() interface{}
+}
+
+// visitToTerraform - converts items page by page, so that only the converted values of all pages are kept in memory.
+func visitToTerraform[T terraformEntity](ctx context.Context, visitAll func(ctx context.Context, visit func(item T) bool) error) ([]interface{}, error) {
+	out := make([]interface{}, 0)
+
+	err := visitAll(ctx, func(item T) bool {
+		out = append(out, item.ToTerraform())
+
+		return true
+	})
+
+	return out, err
+}
+
 func convertConnectorsToTerraform(connectors []*model.Connector) []interface{} {
 	out := make([]interface{}, 0, len(connectors))
 
@@ -17,12 +17,14 @@ func datasourceGroupsRead(ctx context.Context, resourceData *schema.ResourceData
 	c := meta.(*client.Client)
 	filter := buildFilter(resourceData)
 
-	groups, err := c.ReadGroups(ctx, filter)
+	groups, err := visitToTerraform(ctx, func(ctx context.Context, visit func(group *model.Group) bool) error {
+		return c.VisitGroups(ctx, filter, visit)
+	})
 	if err != nil && !errors.Is(err, client.ErrGraphqlResultIsEmpty) {
 		return diag.FromErr(err)
 	}
 
-	if err := resourceData.Set(attr.Groups, convertGroupsToTerraform(groups)); err != nil {
+	if err := resourceData.Set(attr.Groups, groups); err != nil {
 		return diag.FromErr(err)
 	}
 
@@ -15,12 +15,12 @@ import (
 func datasourceRemoteNetworksRead(ctx context.Context, resourceData *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	client := meta.(*client.Client)
 
-	remoteNetworks, err := client.ReadRemoteNetworks(ctx)
+	remoteNetworks, err := visitToTerraform(ctx, client.VisitRemoteNetworks)
 	if err != nil {
 		return diag.FromErr(err)
 	}
 
-	if err := resourceData.Set(attr.RemoteNetworks, convertRemoteNetworksToTerraform(remoteNetworks)); err != nil {
+	if err := resourceData.Set(attr.RemoteNetworks, remoteNetworks); err != nil {
 		return diag.FromErr(err)
 	}
 
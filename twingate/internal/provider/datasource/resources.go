@@ -16,12 +16,14 @@ func datasourceResourcesRead(ctx context.Context, resourceData *schema.ResourceD
 	c := meta.(*client.Client)
 	resourceName := resourceData.Get(attr.Name).(string)
 
-	resources, err := c.ReadResourcesByName(ctx, resourceName)
+	resources, err := visitToTerraform(ctx, func(ctx context.Context, visit func(resource *model.Resource) bool) error {
+		return c.VisitResourcesByName(ctx, resourceName, visit)
+	})
 	if err != nil && !errors.Is(err, client.ErrGraphqlResultIsEmpty) {
 		return diag.FromErr(err)
 	}
 
-	if err := resourceData.Set(attr.Resources, convertResourcesToTerraform(resources)); err != nil {
+	if err := resourceData.Set(attr.Resources, resources); err != nil {
 		return diag.FromErr(err)
 	}
 
@@ -37,16 +37,14 @@ func SecurityPolicies() *schema.Resource {
 }
 
 func readSecurityPolicies(ctx context.Context, resourceData *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*client.Client)
+	c := meta.(*client.Client)
 
-	securityPolicies, err := client.ReadSecurityPolicies(ctx)
+	securityPolicies, err := visitToTerraform(ctx, c.VisitSecurityPolicies)
 	if err != nil {
 		return diag.FromErr(err)
 	}
 
-	data := convertSecurityPoliciesToTerraform(securityPolicies)
-
-	if err := resourceData.Set(attr.SecurityPolicies, data); err != nil {
+	if err := resourceData.Set(attr.SecurityPolicies, securityPolicies); err != nil {
 		return diag.FromErr(err)
 	}
 
@@ -12,14 +12,12 @@ import (
 func datasourceUsersRead(ctx context.Context, resourceData *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	c := meta.(*client.Client)
 
-	users, err := c.ReadUsers(ctx)
+	users, err := visitToTerraform(ctx, c.VisitUsers)
 	if err != nil {
 		return diag.FromErr(err)
 	}
 
-	data := convertUsersToTerraform(users)
-
-	if err := resourceData.Set(attr.Users, data); err != nil {
+	if err := resourceData.Set(attr.Users, users); err != nil {
 		return diag.FromErr(err)
 	}
 
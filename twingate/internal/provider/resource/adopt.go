@@ -0,0 +1,77 @@
+package resource
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/Twingate/terraform-provider-twingate/twingate/internal/attr"
+	"github.com/Twingate/terraform-provider-twingate/twingate/internal/client"
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+type namedEntity interface {
+	GetID() string
+	GetName() string
+}
+
+func adoptExistingSchema(entity string) *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeBool,
+		Optional: true,
+		Description: fmt.Sprintf("When set to `true`, takes ownership of an existing %s with exactly the same name instead of creating a new one. "+
+			"Only applies on create. Defaults to the provider's `adopt_existing` setting.", entity),
+		DiffSuppressFunc: adoptExistingDiff,
+	}
+}
+
+// adoptExistingDiff - changing the setting after create has no effect, so it doesn't cause a diff.
+func adoptExistingDiff(_, _, _ string, resourceData *schema.ResourceData) bool {
+	return resourceData.Id() != ""
+}
+
+// isAdoptExisting - per resource setting takes precedence over the provider default.
+func isAdoptExisting(resourceData *schema.ResourceData, c *client.Client) bool {
+	if flag := getOptionalBoolFlag(resourceData, attr.AdoptExisting); flag != nil {
+		return *flag
+	}
+
+	return c.AdoptExisting
+}
+
+// adoptExisting - looks up an existing object with exactly the given name, and sets its ID to the resource data.
+// Returns true with a warning diagnostic if the object was adopted, or an error if the name is ambiguous.
+func adoptExisting[T namedEntity](ctx context.Context, resourceData *schema.ResourceData, entity, name string,
+	lookup func(ctx context.Context, name string) ([]T, error)) (bool, diag.Diagnostics) {
+	matches, err := lookup(ctx, name)
+	if err != nil && !errors.Is(err, client.ErrNotFound) {
+		return false, apiErrorDiagnostics(err)
+	}
+
+	switch len(matches) {
+	case 0:
+		return false, nil
+	case 1:
+	default:
+		return false, diag.Diagnostics{{
+			Severity:      diag.Error,
+			Summary:       fmt.Sprintf("can't adopt existing %s", entity),
+			Detail:        fmt.Sprintf("found more than one %s named %q, rename them or import one explicitly", entity, name),
+			AttributePath: cty.GetAttrPath(attr.Name),
+		}}
+	}
+
+	existing := matches[0]
+	resourceData.SetId(existing.GetID())
+
+	tflog.Info(ctx, "adopted existing "+entity, map[string]interface{}{attr.ID: existing.GetID(), attr.Name: existing.GetName()})
+
+	return true, diag.Diagnostics{{
+		Severity: diag.Warning,
+		Summary:  fmt.Sprintf("adopted existing %s", entity),
+		Detail:   fmt.Sprintf("%s %q with id %s already existed and is now managed by Terraform", entity, existing.GetName(), existing.GetID()),
+	}}
+}
@@ -0,0 +1,112 @@
+package resource
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/Twingate/terraform-provider-twingate/twingate/internal/attr"
+	"github.com/Twingate/terraform-provider-twingate/twingate/internal/client"
+	"github.com/Twingate/terraform-provider-twingate/twingate/internal/model"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestIsAdoptExisting(t *testing.T) {
+	cases := []struct {
+		config          map[string]interface{}
+		providerDefault bool
+		expected        bool
+	}{
+		{config: map[string]interface{}{}, providerDefault: false, expected: false},
+		{config: map[string]interface{}{}, providerDefault: true, expected: true},
+		{config: map[string]interface{}{attr.AdoptExisting: true}, providerDefault: false, expected: true},
+		{config: map[string]interface{}{attr.AdoptExisting: false}, providerDefault: true, expected: false},
+	}
+
+	for n, c := range cases {
+		t.Run(fmt.Sprintf("case_%d", n), func(t *testing.T) {
+			data := schema.TestResourceDataRaw(t, Group().Schema, c.config)
+
+			assert.Equal(t, c.expected, isAdoptExisting(data, &client.Client{AdoptExisting: c.providerDefault}))
+		})
+	}
+}
+
+func TestAdoptExistingDiff(t *testing.T) {
+	cases := []struct {
+		id       string
+		expected bool
+	}{
+		{id: "", expected: false},
+		{id: "id-1", expected: true},
+	}
+
+	for n, c := range cases {
+		t.Run(fmt.Sprintf("case_%d", n), func(t *testing.T) {
+			data := schema.TestResourceDataRaw(t, Group().Schema, map[string]interface{}{})
+			data.SetId(c.id)
+
+			assert.Equal(t, c.expected, adoptExistingDiff(attr.AdoptExisting, "false", "true", data))
+		})
+	}
+}
+
+func TestAdoptExisting(t *testing.T) {
+	errLookup := errors.New("lookup failed")
+
+	cases := []struct {
+		matches          []*model.Group
+		err              error
+		expectedAdopted  bool
+		expectedID       string
+		expectedSeverity *diag.Severity
+	}{
+		{
+			err: client.NewAPIError(client.ErrGraphqlResultIsEmpty, "read", "group"),
+		},
+		{
+			matches:          []*model.Group{{ID: "id-1", Name: "test"}},
+			expectedAdopted:  true,
+			expectedID:       "id-1",
+			expectedSeverity: severity(diag.Warning),
+		},
+		{
+			matches:          []*model.Group{{ID: "id-1", Name: "test"}, {ID: "id-2", Name: "test"}},
+			expectedSeverity: severity(diag.Error),
+		},
+		{
+			err:              errLookup,
+			expectedSeverity: severity(diag.Error),
+		},
+	}
+
+	for n, c := range cases {
+		t.Run(fmt.Sprintf("case_%d", n), func(t *testing.T) {
+			data := schema.TestResourceDataRaw(t, Group().Schema, map[string]interface{}{attr.Name: "test"})
+
+			adopted, diags := adoptExisting(context.Background(), data, "group", "test",
+				func(ctx context.Context, name string) ([]*model.Group, error) {
+					assert.Equal(t, "test", name)
+
+					return c.matches, c.err
+				})
+
+			assert.Equal(t, c.expectedAdopted, adopted)
+			assert.Equal(t, c.expectedID, data.Id())
+
+			if c.expectedSeverity == nil {
+				assert.Empty(t, diags)
+			} else {
+				assert.Len(t, diags, 1)
+				assert.Equal(t, *c.expectedSeverity, diags[0].Severity)
+			}
+		})
+	}
+}
+
+func severity(s diag.Severity) *diag.Severity {
+	return &s
+}
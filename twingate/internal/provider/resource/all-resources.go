@@ -6,6 +6,7 @@ const (
 	TwingateConnectorTokens   = "twingate_connector_tokens"
 	TwingateGroup             = "twingate_group"
 	TwingateResource          = "twingate_resource"
+	TwingateResourceSet       = "twingate_resource_set"
 	TwingateServiceAccount    = "twingate_service_account"
 	TwingateServiceAccountKey = "twingate_service_account_key"
 	TwingateUser              = "twingate_user"
@@ -3,10 +3,10 @@ package resource
 import (
 	"context"
 	"fmt"
-	"log"
 
 	"github.com/Twingate/terraform-provider-twingate/twingate/internal/attr"
 	"github.com/Twingate/terraform-provider-twingate/twingate/internal/client"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
@@ -17,6 +17,11 @@ func ConnectorTokens() *schema.Resource {
 		CreateContext: resourceConnectorTokensCreate,
 		ReadContext:   resourceConnectorTokensRead,
 		DeleteContext: resourceConnectorTokensDelete,
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(defaultTimeout),
+			Read:   schema.DefaultTimeout(defaultTimeout),
+			Delete: schema.DefaultTimeout(defaultTimeout),
+		},
 
 		Schema: map[string]*schema.Schema{
 			// required
@@ -58,7 +63,7 @@ func resourceConnectorTokensCreate(ctx context.Context, resourceData *schema.Res
 
 	tokens, err := c.GenerateConnectorTokens(ctx, connectorID)
 	if err != nil {
-		return diag.FromErr(err)
+		return apiErrorDiagnostics(err)
 	}
 
 	if err := resourceData.Set(attr.AccessToken, tokens.AccessToken); err != nil {
@@ -79,10 +84,10 @@ func resourceConnectorTokensDelete(ctx context.Context, resourceData *schema.Res
 	_, err := c.GenerateConnectorTokens(ctx, resourceData.Id())
 
 	if err != nil {
-		return diag.FromErr(err)
+		return apiErrorDiagnostics(err)
 	}
 
-	log.Printf("[INFO] Invalidating Connector Tokens id %s", resourceData.Id())
+	tflog.Info(ctx, "invalidated connector tokens", map[string]interface{}{attr.ID: resourceData.Id()})
 	resourceData.SetId("")
 
 	return nil
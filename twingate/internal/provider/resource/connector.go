@@ -3,11 +3,11 @@ package resource
 import (
 	"context"
 	"errors"
-	"log"
 
 	"github.com/Twingate/terraform-provider-twingate/twingate/internal/attr"
 	"github.com/Twingate/terraform-provider-twingate/twingate/internal/client"
 	"github.com/Twingate/terraform-provider-twingate/twingate/internal/model"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
@@ -21,6 +21,7 @@ func Connector() *schema.Resource {
 		ReadContext:   connectorRead,
 		DeleteContext: connectorDelete,
 		UpdateContext: connectorUpdate,
+		Timeouts:      newTimeouts(defaultTimeout),
 		CustomizeDiff: func(ctx context.Context, d *schema.ResourceDiff, m interface{}) error {
 			oldVal, _ := d.GetChange(attr.RemoteNetworkID)
 			old := oldVal.(string)
@@ -58,9 +59,7 @@ func Connector() *schema.Resource {
 				Description: "Determines whether status notifications are enabled for the Connector.",
 			},
 		},
-		Importer: &schema.ResourceImporter{
-			StateContext: schema.ImportStatePassthroughContext,
-		},
+		Importer: importByParent("connector", lookupRemoteNetworksByName, lookupNetworkConnectorsByName),
 	}
 }
 
@@ -104,10 +103,10 @@ func connectorDelete(ctx context.Context, resourceData *schema.ResourceData, met
 
 	err := c.DeleteConnector(ctx, connectorID)
 	if err != nil {
-		return diag.FromErr(err)
+		return apiErrorDiagnostics(err)
 	}
 
-	log.Printf("[INFO] Destroyed connector id %s", connectorID)
+	tflog.Info(ctx, "deleted connector", map[string]interface{}{attr.ID: connectorID})
 
 	return nil
 }
@@ -121,14 +120,14 @@ func connectorRead(ctx context.Context, resourceData *schema.ResourceData, meta
 
 func resourceConnectorReadHelper(resourceData *schema.ResourceData, connector *model.Connector, err error) diag.Diagnostics {
 	if err != nil {
-		if errors.Is(err, client.ErrGraphqlResultIsEmpty) {
+		if errors.Is(err, client.ErrNotFound) {
 			// clear state
 			resourceData.SetId("")
 
 			return nil
 		}
 
-		return diag.FromErr(err)
+		return apiErrorDiagnostics(err)
 	}
 
 	if err := resourceData.Set(attr.Name, connector.Name); err != nil {
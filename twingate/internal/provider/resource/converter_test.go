@@ -7,6 +7,7 @@ import (
 
 	"github.com/Twingate/terraform-provider-twingate/twingate/internal/attr"
 	"github.com/Twingate/terraform-provider-twingate/twingate/internal/model"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -45,12 +46,40 @@ func TestConvertProtocol(t *testing.T) {
 				},
 			},
 		},
+		{
+			input: []interface{}{
+				map[string]interface{}{
+					attr.Policy:   model.PolicyRestricted,
+					attr.Ports:    []interface{}{"8080"},
+					attr.Services: []interface{}{"ssh", "postgres"},
+				},
+			},
+			expected: &model.Protocol{
+				Policy:   model.PolicyRestricted,
+				Ports:    []*model.PortRange{{Start: 8080, End: 8080}},
+				Services: []string{"ssh", "postgres"},
+				ServicePorts: []*model.PortRange{
+					{Start: 22, End: 22},
+					{Start: 5432, End: 5432},
+				},
+			},
+		},
+		{
+			input: []interface{}{
+				map[string]interface{}{
+					attr.Policy:   model.PolicyAllowAll,
+					attr.Ports:    []interface{}{},
+					attr.Services: []interface{}{"ssh"},
+				},
+			},
+			expectedErr: errors.New("services can only be used with the RESTRICTED policy, got ALLOW_ALL"),
+		},
 	}
 
 	for n, c := range cases {
 		t.Run(fmt.Sprintf("case_%d", n), func(t *testing.T) {
 
-			protocol, err := convertProtocol(c.input)
+			protocol, err := convertProtocol(c.input, model.TransportTCP)
 
 			assert.Equal(t, c.expected, protocol)
 			if c.expectedErr != nil {
@@ -62,110 +91,62 @@ func TestConvertProtocol(t *testing.T) {
 
 }
 
-func TestConvertPortsRangeToMap(t *testing.T) {
-	cases := []struct {
-		portsRange []*model.PortRange
-		expected   map[int]struct{}
-	}{
-		{
-			portsRange: nil,
-			expected:   map[int]struct{}{},
-		},
-		{
-			portsRange: []*model.PortRange{
-				{
-					Start: 70,
-					End:   70,
-				},
-				{
-					Start: 81,
-					End:   85,
-				},
-			},
-			expected: map[int]struct{}{
-				70: {},
-				81: {},
-				82: {},
-				83: {},
-				84: {},
-				85: {},
-			},
-		},
-		{
-			portsRange: []*model.PortRange{
-				{
-					Start: 80,
-					End:   83,
-				},
-				{
-					Start: 81,
-					End:   85,
-				},
-				{
-					Start: 81,
-					End:   82,
+func TestProtocolsWithIntent(t *testing.T) {
+	config := func(tcp map[string]interface{}) map[string]interface{} {
+		return map[string]interface{}{
+			attr.Protocols: []interface{}{
+				map[string]interface{}{
+					attr.AllowIcmp: true,
+					attr.TCP:       []interface{}{tcp},
+					attr.UDP:       []interface{}{map[string]interface{}{attr.Policy: model.PolicyAllowAll}},
 				},
 			},
-			expected: map[int]struct{}{
-				80: {},
-				81: {},
-				82: {},
-				83: {},
-				84: {},
-				85: {},
-			},
-		},
+		}
 	}
 
-	for n, c := range cases {
-		t.Run(fmt.Sprintf("case_%d", n), func(t *testing.T) {
-			actual := convertPortsRangeToMap(c.portsRange)
-			assert.Equal(t, c.expected, actual)
-		})
+	protocols := func(tcp *model.Protocol) *model.Protocols {
+		return &model.Protocols{AllowIcmp: true, TCP: tcp, UDP: model.NewProtocol(model.PolicyAllowAll, nil)}
 	}
-}
 
-func TestEqualPorts(t *testing.T) {
 	cases := []struct {
-		inputA   []interface{}
-		inputB   []interface{}
-		expected bool
+		config   map[string]interface{}
+		read     *model.Protocols
+		expected *model.Protocols
 	}{
 		{
-			inputA:   []interface{}{""},
-			inputB:   []interface{}{""},
-			expected: false,
-		},
-		{
-			inputA:   []interface{}{"80"},
-			inputB:   []interface{}{""},
-			expected: false,
+			config:   map[string]interface{}{},
+			read:     model.DefaultProtocols(),
+			expected: nil,
 		},
 		{
-			inputA:   []interface{}{"80"},
-			inputB:   []interface{}{"90"},
-			expected: false,
+			config:   map[string]interface{}{},
+			read:     protocols(model.NewProtocol(model.PolicyDenyAll, nil)),
+			expected: protocols(model.NewProtocol(model.PolicyDenyAll, nil)),
 		},
 		{
-			inputA:   []interface{}{"80"},
-			inputB:   []interface{}{"80"},
-			expected: true,
+			config: config(map[string]interface{}{attr.Policy: model.PolicyRestricted, attr.Ports: []interface{}{"81", "80"}}),
+			read:   protocols(model.NewProtocol(model.PolicyRestricted, []*model.PortRange{{Start: 80, End: 81}})),
+			expected: protocols(model.NewProtocol(model.PolicyRestricted,
+				[]*model.PortRange{{Start: 81, End: 81}, {Start: 80, End: 80}})),
 		},
 		{
-			inputA:   []interface{}{"80-81"},
-			inputB:   []interface{}{"80", "81"},
-			expected: true,
+			config:   config(map[string]interface{}{attr.Policy: model.PolicyRestricted, attr.Ports: []interface{}{"80"}}),
+			read:     protocols(model.NewProtocol(model.PolicyRestricted, []*model.PortRange{{Start: 90, End: 90}})),
+			expected: protocols(model.NewProtocol(model.PolicyRestricted, []*model.PortRange{{Start: 90, End: 90}})),
 		},
 		{
-			inputA:   []interface{}{"80-81", "70"},
-			inputB:   []interface{}{"70", "80", "81"},
-			expected: true,
+			config:   config(map[string]interface{}{attr.Policy: model.PolicyRestricted}),
+			read:     protocols(model.NewProtocol(model.PolicyDenyAll, nil)),
+			expected: protocols(model.NewProtocol(model.PolicyRestricted, []*model.PortRange{})),
 		},
 	}
 
 	for n, c := range cases {
 		t.Run(fmt.Sprintf("case_%d", n), func(t *testing.T) {
-			actual := equalPorts(c.inputA, c.inputB)
+			resourceData := schema.TestResourceDataRaw(t, Resource().Schema, c.config)
+
+			actual := protocolsWithIntent(resourceData, c.read)
+
 			assert.Equal(t, c.expected, actual)
 		})
 	}
@@ -4,11 +4,11 @@ import (
 	"context"
 	"errors"
 	"fmt"
-	"log"
 
 	"github.com/Twingate/terraform-provider-twingate/twingate/internal/attr"
 	"github.com/Twingate/terraform-provider-twingate/twingate/internal/client"
 	"github.com/Twingate/terraform-provider-twingate/twingate/internal/model"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
@@ -24,6 +24,7 @@ func Group() *schema.Resource {
 		ReadContext:   groupRead,
 		DeleteContext: groupDelete,
 		UpdateContext: groupUpdate,
+		Timeouts:      newTimeouts(defaultTimeout),
 
 		Schema: map[string]*schema.Schema{
 			attr.Name: {
@@ -44,6 +45,7 @@ func Group() *schema.Resource {
 				Optional:    true,
 				Description: "List of User IDs that have permission to access the Group.",
 			},
+			attr.AdoptExisting: adoptExistingSchema("Group"),
 			// computed
 			attr.SecurityPolicyID: {
 				Type:        schema.TypeString,
@@ -57,21 +59,33 @@ func Group() *schema.Resource {
 				Description: "Autogenerated ID of the Resource, encoded in base64",
 			},
 		},
-		Importer: &schema.ResourceImporter{
-			StateContext: schema.ImportStatePassthroughContext,
-		},
+		Importer: importByPrefix("group", importPrefixName, lookupGroupsByName),
 	}
 }
 
 func groupCreate(ctx context.Context, resourceData *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	c := meta.(*client.Client)
 
+	if isAdoptExisting(resourceData, c) {
+		adopted, diags := adoptExisting(ctx, resourceData, "group", resourceData.Get(attr.Name).(string),
+			func(ctx context.Context, name string) ([]*model.Group, error) {
+				return lookupGroupsByName(ctx, c, name)
+			})
+		if adopted {
+			return append(diags, groupUpdate(ctx, resourceData, meta)...)
+		}
+
+		if diags.HasError() {
+			return diags
+		}
+	}
+
 	group, err := c.CreateGroup(ctx, convertGroup(resourceData))
 	if err != nil {
-		return diag.FromErr(err)
+		return apiErrorDiagnostics(err)
 	}
 
-	log.Printf("[INFO] Group %s created with id %v", group.Name, group.ID)
+	tflog.Info(ctx, "created group", map[string]interface{}{attr.ID: group.ID, attr.Name: group.Name})
 
 	return resourceGroupReadHelper(resourceData, group, nil)
 }
@@ -80,23 +94,26 @@ func groupUpdate(ctx context.Context, resourceData *schema.ResourceData, meta in
 	client := meta.(*client.Client)
 	group := convertGroup(resourceData)
 
+	unlock := client.LockEntity(group.ID)
+	defer unlock()
+
 	remoteGroup, err := isAllowedToChangeGroup(ctx, group.ID, client)
 	if err != nil {
-		return diag.FromErr(err)
+		return apiErrorDiagnostics(err)
 	}
 
 	oldIDs := getOldGroupUserIDs(resourceData, group, remoteGroup)
 	if err := client.DeleteGroupUsers(ctx, group.ID, setDifference(oldIDs, group.Users)); err != nil {
-		return diag.FromErr(err)
+		return apiErrorDiagnostics(err)
 	}
 
 	group, err = client.UpdateGroup(ctx, group)
 
 	if err != nil {
-		return diag.FromErr(err)
+		return apiErrorDiagnostics(err)
 	}
 
-	log.Printf("[INFO] Updated group id %v", group.ID)
+	tflog.Info(ctx, "updated group", map[string]interface{}{attr.ID: group.ID})
 
 	return resourceGroupReadHelper(resourceData, group, err)
 }
@@ -116,14 +133,14 @@ func groupDelete(ctx context.Context, resourceData *schema.ResourceData, meta in
 	groupID := resourceData.Id()
 
 	if _, err := isAllowedToChangeGroup(ctx, groupID, client); err != nil {
-		return diag.FromErr(err)
+		return apiErrorDiagnostics(err)
 	}
 
 	if err := client.DeleteGroup(ctx, groupID); err != nil {
-		return diag.FromErr(err)
+		return apiErrorDiagnostics(err)
 	}
 
-	log.Printf("[INFO] Deleted group id %s", resourceData.Id())
+	tflog.Info(ctx, "deleted group", map[string]interface{}{attr.ID: resourceData.Id()})
 
 	return nil
 }
@@ -154,14 +171,14 @@ func groupRead(ctx context.Context, resourceData *schema.ResourceData, meta inte
 
 func resourceGroupReadHelper(resourceData *schema.ResourceData, group *model.Group, err error) diag.Diagnostics {
 	if err != nil {
-		if errors.Is(err, client.ErrGraphqlResultIsEmpty) {
+		if errors.Is(err, client.ErrNotFound) {
 			// clear state
 			resourceData.SetId("")
 
 			return nil
 		}
 
-		return diag.FromErr(err)
+		return apiErrorDiagnostics(err)
 	}
 
 	resourceData.SetId(group.ID)
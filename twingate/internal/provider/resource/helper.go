@@ -1,17 +1,155 @@
 package resource
 
 import (
+	"errors"
 	"fmt"
+	"strings"
+	"time"
 
+	"github.com/Twingate/terraform-provider-twingate/twingate/internal/attr"
+	"github.com/Twingate/terraform-provider-twingate/twingate/internal/client"
 	"github.com/Twingate/terraform-provider-twingate/twingate/internal/utils"
+	"github.com/hashicorp/go-cty/cty"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
+// defaultTimeout - time limit of a create, read, update or delete operation, including retries of failed requests.
+const defaultTimeout = 5 * time.Minute
+
+// newTimeouts - default timeouts of all operations, they can be changed with the `timeouts {}` block of a resource.
+// The context passed to the operation expires at the deadline, which stops retries of failed requests.
+func newTimeouts(timeout time.Duration) *schema.ResourceTimeout {
+	return &schema.ResourceTimeout{
+		Create: schema.DefaultTimeout(timeout),
+		Read:   schema.DefaultTimeout(timeout),
+		Update: schema.DefaultTimeout(timeout),
+		Delete: schema.DefaultTimeout(timeout),
+	}
+}
+
 func ErrAttributeSet(err error, attribute string) diag.Diagnostics {
 	return diag.FromErr(fmt.Errorf("error setting %s: %w ", attribute, err))
 }
 
+// apiErrorDiagnostics - converts classified API errors into diagnostics with a precise summary,
+// pointing to the attribute reported by the API. Other errors are returned as is.
+func apiErrorDiagnostics(err error) diag.Diagnostics {
+	var apiErr *client.APIError
+	if !errors.As(err, &apiErr) || apiErr.Class == nil {
+		return diag.FromErr(err)
+	}
+
+	diagnostic := diag.Diagnostic{
+		Severity: diag.Error,
+		Detail:   err.Error(),
+	}
+
+	switch {
+	case errors.Is(err, client.ErrPermissionDenied):
+		access := "read"
+		if apiErr.IsWrite() {
+			access = "write"
+		}
+
+		diagnostic.Summary = fmt.Sprintf("API token lacks %s permission", access)
+		diagnostic.Detail = fmt.Sprintf("%s. Make sure the API token has %s permission in the Twingate Admin Console.", err.Error(), access)
+	case errors.Is(err, client.ErrConflict) && isNameConflict(apiErr):
+		diagnostic.Summary = "duplicate name"
+		diagnostic.Detail = fmt.Sprintf("%s with the same name already exists: %s", apiErr.Resource, err.Error())
+		diagnostic.AttributePath = apiFieldPath(apiErr.Resource, apiFieldName)
+	case errors.Is(err, client.ErrConflict):
+		diagnostic.Summary = fmt.Sprintf("%s conflict", apiErr.Resource)
+	case errors.Is(err, client.ErrNotFound):
+		diagnostic.Summary = fmt.Sprintf("%s not found", apiErr.Resource)
+	case errors.Is(err, client.ErrValidation):
+		diagnostic.Summary = fmt.Sprintf("invalid %s", apiErr.Resource)
+	}
+
+	if path := apiFieldPath(apiErr.Resource, apiErr.Field); path != nil {
+		diagnostic.AttributePath = path
+	}
+
+	return diag.Diagnostics{diagnostic}
+}
+
+const apiFieldName = "name"
+
+// isNameConflict - conflicts on other fields, e.g. an address already in use, are reported by the field or message.
+func isNameConflict(apiErr *client.APIError) bool {
+	if apiErr.Field != "" {
+		return apiErr.Field == apiFieldName
+	}
+
+	return apiErr.WrappedError != nil && strings.Contains(strings.ToLower(apiErr.WrappedError.Error()), apiFieldName)
+}
+
+// apiFieldPaths - attributes of the API input fields reported in errors, by the API resource of the failed operation.
+// Fields without a matching attribute, e.g. of nested objects, are not listed.
+//
+//nolint:gochecknoglobals
+var apiFieldPaths = map[string]map[string]cty.Path{
+	"connector": {
+		"name":                          cty.GetAttrPath(attr.Name),
+		"remoteNetworkId":               cty.GetAttrPath(attr.RemoteNetworkID),
+		"hasStatusNotificationsEnabled": cty.GetAttrPath(attr.StatusUpdatesEnabled),
+	},
+	"group": {
+		"name":             cty.GetAttrPath(attr.Name),
+		"userIds":          cty.GetAttrPath(attr.UserIDs),
+		"addedUserIds":     cty.GetAttrPath(attr.UserIDs),
+		"removedUserIds":   cty.GetAttrPath(attr.UserIDs),
+		"securityPolicyId": cty.GetAttrPath(attr.SecurityPolicyID),
+	},
+	"remote network": {
+		"name":     cty.GetAttrPath(attr.Name),
+		"location": cty.GetAttrPath(attr.Location),
+	},
+	"resource": {
+		"name":                     cty.GetAttrPath(attr.Name),
+		"address":                  cty.GetAttrPath(attr.Address),
+		"alias":                    cty.GetAttrPath(attr.Alias),
+		"remoteNetworkId":          cty.GetAttrPath(attr.RemoteNetworkID),
+		"isVisible":                cty.GetAttrPath(attr.IsVisible),
+		"isBrowserShortcutEnabled": cty.GetAttrPath(attr.IsBrowserShortcutEnabled),
+		"protocols":                cty.GetAttrPath(attr.Protocols).IndexInt(0),
+		"groupIds":                 cty.GetAttrPath(attr.Access).IndexInt(0).GetAttr(attr.GroupIDs),
+	},
+	"service account": {
+		"name": cty.GetAttrPath(attr.Name),
+	},
+	"service account key": {
+		"name":             cty.GetAttrPath(attr.Name),
+		"serviceAccountId": cty.GetAttrPath(attr.ServiceAccountID),
+	},
+	"user": {
+		"email":            cty.GetAttrPath(attr.Email),
+		"firstName":        cty.GetAttrPath(attr.FirstName),
+		"lastName":         cty.GetAttrPath(attr.LastName),
+		"role":             cty.GetAttrPath(attr.Role),
+		"shouldSendInvite": cty.GetAttrPath(attr.SendInvite),
+	},
+}
+
+func apiFieldPath(resource, field string) cty.Path {
+	if field == "" {
+		return nil
+	}
+
+	return apiFieldPaths[resource][field]
+}
+
+// nestedAPIErrorDiagnostics - same as apiErrorDiagnostics, for objects managed in a nested block,
+// where the attributes of the API fields are not at the top level.
+func nestedAPIErrorDiagnostics(err error) diag.Diagnostics {
+	diags := apiErrorDiagnostics(err)
+	for i := range diags {
+		diags[i].AttributePath = nil
+	}
+
+	return diags
+}
+
 func castToStrings(a, b interface{}) (string, string) {
 	return a.(string), b.(string)
 }
@@ -1,9 +1,14 @@
 package resource
 
 import (
+	"errors"
 	"fmt"
 	"testing"
 
+	"github.com/Twingate/terraform-provider-twingate/twingate/internal/attr"
+	"github.com/Twingate/terraform-provider-twingate/twingate/internal/client"
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hasura/go-graphql-client"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -145,3 +150,110 @@ func TestWithDefaultValue(t *testing.T) {
 		})
 	}
 }
+
+func TestAPIErrorDiagnostics(t *testing.T) {
+	errUnknown := errors.New("unknown error")
+
+	cases := []struct {
+		err             error
+		expectedSummary string
+		expectedPath    cty.Path
+	}{
+		{
+			err:             errUnknown,
+			expectedSummary: "unknown error",
+		},
+		{
+			err:             client.NewAPIError(errUnknown, "create", "group"),
+			expectedSummary: "failed to create group: unknown error",
+		},
+		{
+			err:             client.NewAPIError(client.NewMutationError("Not authorized"), "create", "group"),
+			expectedSummary: "API token lacks write permission",
+		},
+		{
+			err:             client.NewAPIError(client.NewMutationError("Character '_' is not allowed in alias"), "update", "resource"),
+			expectedSummary: "invalid resource",
+		},
+		{
+			err:             client.NewAPIErrorWithID(client.ErrGraphqlResultIsEmpty, "read", "group", "id"),
+			expectedSummary: "group not found",
+		},
+		{
+			err:             client.NewAPIErrorWithName(client.NewMutationError("Name already exists"), "create", "group", "test"),
+			expectedSummary: "duplicate name",
+			expectedPath:    cty.GetAttrPath(attr.Name),
+		},
+		{
+			err: client.NewAPIError(graphql.Errors{{
+				Message:    "bad input",
+				Extensions: map[string]interface{}{"code": "BAD_USER_INPUT", "field": "remoteNetworkId"},
+			}}, "update", "resource"),
+			expectedSummary: "invalid resource",
+			expectedPath:    cty.GetAttrPath(attr.RemoteNetworkID),
+		},
+		{
+			err: client.NewAPIError(graphql.Errors{{
+				Message:    "bad input",
+				Extensions: map[string]interface{}{"code": "BAD_USER_INPUT", "field": "groupIds"},
+			}}, "update", "resource"),
+			expectedSummary: "invalid resource",
+			expectedPath:    cty.GetAttrPath(attr.Access).IndexInt(0).GetAttr(attr.GroupIDs),
+		},
+		{
+			err: client.NewAPIError(graphql.Errors{{
+				Message:    "bad input",
+				Extensions: map[string]interface{}{"code": "BAD_USER_INPUT", "field": "ports"},
+			}}, "update", "resource"),
+			expectedSummary: "invalid resource",
+		},
+		{
+			err:             client.NewAPIErrorWithName(client.NewMutationError("Email already exists"), "create", "user", "test"),
+			expectedSummary: "user conflict",
+		},
+		{
+			err:             client.NewAPIError(client.NewMutationError("Alias is already in use"), "create", "resource"),
+			expectedSummary: "resource conflict",
+		},
+		{
+			err: client.NewAPIError(graphql.Errors{{
+				Message:    "already exists",
+				Extensions: map[string]interface{}{"code": "CONFLICT", "field": "address"},
+			}}, "create", "resource"),
+			expectedSummary: "resource conflict",
+			expectedPath:    cty.GetAttrPath(attr.Address),
+		},
+		{
+			err: client.NewAPIError(graphql.Errors{{
+				Message:    "already exists",
+				Extensions: map[string]interface{}{"code": "CONFLICT", "field": "name"},
+			}}, "create", "resource"),
+			expectedSummary: "duplicate name",
+			expectedPath:    cty.GetAttrPath(attr.Name),
+		},
+	}
+
+	for n, c := range cases {
+		t.Run(fmt.Sprintf("case_%d", n), func(t *testing.T) {
+			diags := apiErrorDiagnostics(c.err)
+
+			assert.Len(t, diags, 1)
+			assert.True(t, diags.HasError())
+			assert.Equal(t, c.expectedSummary, diags[0].Summary)
+			assert.Equal(t, c.expectedPath, diags[0].AttributePath)
+		})
+	}
+}
+
+func TestNestedAPIErrorDiagnostics(t *testing.T) {
+	err := client.NewAPIError(graphql.Errors{{
+		Message:    "bad input",
+		Extensions: map[string]interface{}{"code": "BAD_USER_INPUT", "field": "address"},
+	}}, "create", "resource")
+
+	assert.Equal(t, cty.GetAttrPath(attr.Address), apiErrorDiagnostics(err)[0].AttributePath)
+
+	diags := nestedAPIErrorDiagnostics(err)
+	assert.Equal(t, "invalid resource", diags[0].Summary)
+	assert.Nil(t, diags[0].AttributePath)
+}
@@ -0,0 +1,162 @@
+package resource
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/Twingate/terraform-provider-twingate/twingate/internal/client"
+	"github.com/Twingate/terraform-provider-twingate/twingate/internal/model"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+const (
+	importPrefixName  = "name:"
+	importPrefixEmail = "email:"
+	importSeparator   = "/"
+
+	// maxLookupMatches - lookups stop paging after the second match, more than one match is ambiguous anyway.
+	maxLookupMatches = 2
+)
+
+var (
+	ErrImportNotFound  = errors.New("nothing matches the import ID")
+	ErrImportAmbiguous = errors.New("import ID is ambiguous")
+)
+
+type importLookup[T namedEntity] func(ctx context.Context, c *client.Client, value string) ([]T, error)
+
+type importChildLookup[P, T namedEntity] func(ctx context.Context, c *client.Client, parent P, name string) ([]T, error)
+
+// importByPrefix - resolves import IDs like `name:<name>` into IDs with the given lookup.
+// Import IDs without the prefix are imported as is.
+func importByPrefix[T namedEntity](entity, prefix string, lookup importLookup[T]) *schema.ResourceImporter {
+	return &schema.ResourceImporter{
+		StateContext: func(ctx context.Context, data *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+			importID := data.Id()
+
+			value, ok := strings.CutPrefix(importID, prefix)
+			if !ok {
+				return schema.ImportStatePassthroughContext(ctx, data, meta)
+			}
+
+			matches, err := lookup(ctx, meta.(*client.Client), value)
+
+			return importResolved(data, entity, importID, matches, err)
+		},
+	}
+}
+
+// importByParent - resolves import IDs like `<parent name>/<name>` into IDs, e.g. `network/<name>` for connectors.
+// Since base64 IDs may contain the separator, import IDs whose parent doesn't exist are imported as is.
+func importByParent[P, T namedEntity](entity string, parentLookup importLookup[P], lookup importChildLookup[P, T]) *schema.ResourceImporter {
+	return &schema.ResourceImporter{
+		StateContext: func(ctx context.Context, data *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+			importID := data.Id()
+
+			parentName, name, ok := strings.Cut(importID, importSeparator)
+			if !ok {
+				return schema.ImportStatePassthroughContext(ctx, data, meta)
+			}
+
+			c := meta.(*client.Client)
+
+			parents, err := parentLookup(ctx, c, parentName)
+			if err != nil && !errors.Is(err, client.ErrNotFound) {
+				return nil, err
+			}
+
+			switch len(parents) {
+			case 0:
+				return schema.ImportStatePassthroughContext(ctx, data, meta)
+			case 1:
+			default:
+				return nil, fmt.Errorf("%w: more than one parent of %s matches %q, import by ID instead", ErrImportAmbiguous, entity, parentName)
+			}
+
+			matches, err := lookup(ctx, c, parents[0], name)
+
+			return importResolved(data, entity, importID, matches, err)
+		},
+	}
+}
+
+func importResolved[T namedEntity](data *schema.ResourceData, entity, importID string, matches []T, err error) ([]*schema.ResourceData, error) {
+	if err != nil && !errors.Is(err, client.ErrNotFound) {
+		return nil, err
+	}
+
+	switch len(matches) {
+	case 0:
+		return nil, fmt.Errorf("%w: no %s matches %q", ErrImportNotFound, entity, importID)
+	case 1:
+		data.SetId(matches[0].GetID())
+
+		return []*schema.ResourceData{data}, nil
+	default:
+		return nil, fmt.Errorf("%w: more than one %s matches %q, import by ID instead", ErrImportAmbiguous, entity, importID)
+	}
+}
+
+func lookupGroupsByName(ctx context.Context, c *client.Client, name string) ([]*model.Group, error) {
+	return client.CollectMatches(ctx, func(ctx context.Context, visit func(group *model.Group) bool) error {
+		return c.VisitGroups(ctx, &model.GroupsFilter{Name: &name}, visit)
+	}, func(group *model.Group) bool {
+		return group.Name == name
+	}, maxLookupMatches)
+}
+
+func lookupRemoteNetworksByName(ctx context.Context, c *client.Client, name string) ([]*model.RemoteNetwork, error) {
+	return client.CollectMatches(ctx, c.VisitRemoteNetworks, func(network *model.RemoteNetwork) bool {
+		return network.Name == name
+	}, maxLookupMatches)
+}
+
+func lookupServiceAccountsByName(ctx context.Context, c *client.Client, name string) ([]*model.ServiceAccount, error) {
+	return client.CollectMatches(ctx, c.VisitShallowServiceAccounts, func(serviceAccount *model.ServiceAccount) bool {
+		return serviceAccount.Name == name
+	}, maxLookupMatches)
+}
+
+func lookupUsersByEmail(ctx context.Context, c *client.Client, email string) ([]*model.User, error) {
+	return client.CollectMatches(ctx, c.VisitUsers, func(user *model.User) bool {
+		return strings.EqualFold(user.Email, email)
+	}, maxLookupMatches)
+}
+
+func lookupNetworkConnectorsByName(ctx context.Context, c *client.Client, network *model.RemoteNetwork, name string) ([]*model.Connector, error) {
+	return client.CollectMatches(ctx, c.VisitConnectors, func(connector *model.Connector) bool {
+		return connector.NetworkID == network.ID && connector.Name == name
+	}, maxLookupMatches)
+}
+
+func lookupNetworkResourcesByName(ctx context.Context, c *client.Client, network *model.RemoteNetwork, name string) ([]*model.Resource, error) {
+	return client.CollectMatches(ctx, func(ctx context.Context, visit func(resource *model.Resource) bool) error {
+		return c.VisitResourcesByName(ctx, name, visit)
+	}, func(resource *model.Resource) bool {
+		return resource.RemoteNetworkID == network.ID && resource.Name == name
+	}, maxLookupMatches)
+}
+
+func lookupServiceAccountKeysByName(ctx context.Context, c *client.Client, account *model.ServiceAccount, name string) ([]*model.ServiceKey, error) {
+	serviceAccount, err := c.ReadServiceAccount(ctx, account.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	var keys []*model.ServiceKey
+
+	for _, keyID := range serviceAccount.Keys {
+		key, err := c.ReadServiceKey(ctx, keyID)
+		if err != nil {
+			return nil, err
+		}
+
+		if key.Name == name {
+			keys = append(keys, key)
+		}
+	}
+
+	return keys, nil
+}
@@ -0,0 +1,109 @@
+package resource
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/Twingate/terraform-provider-twingate/twingate/internal/client"
+	"github.com/Twingate/terraform-provider-twingate/twingate/internal/model"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestImportResolved(t *testing.T) {
+	errLookup := errors.New("lookup failed")
+
+	cases := []struct {
+		matches     []*model.Group
+		err         error
+		expectedID  string
+		expectedErr error
+	}{
+		{
+			matches:    []*model.Group{{ID: "id-1", Name: "test"}},
+			expectedID: "id-1",
+		},
+		{
+			expectedErr: ErrImportNotFound,
+		},
+		{
+			err:         client.NewAPIError(client.ErrGraphqlResultIsEmpty, "read", "group"),
+			expectedErr: ErrImportNotFound,
+		},
+		{
+			matches:     []*model.Group{{ID: "id-1", Name: "test"}, {ID: "id-2", Name: "test"}},
+			expectedErr: ErrImportAmbiguous,
+		},
+		{
+			err:         errLookup,
+			expectedErr: errLookup,
+		},
+	}
+
+	for n, c := range cases {
+		t.Run(fmt.Sprintf("case_%d", n), func(t *testing.T) {
+			data := Group().Data(nil)
+			data.SetId("name:test")
+
+			result, err := importResolved(data, "group", "name:test", c.matches, c.err)
+
+			if c.expectedErr != nil {
+				assert.ErrorIs(t, err, c.expectedErr)
+				assert.Nil(t, result)
+
+				return
+			}
+
+			assert.NoError(t, err)
+			assert.Len(t, result, 1)
+			assert.Equal(t, c.expectedID, result[0].Id())
+		})
+	}
+}
+
+func TestImportPassthrough(t *testing.T) {
+	failingLookup := func(ctx context.Context, c *client.Client, value string) ([]*model.RemoteNetwork, error) {
+		t.Fatal("lookup must not be called")
+
+		return nil, nil
+	}
+
+	failingChildLookup := func(ctx context.Context, c *client.Client, network *model.RemoteNetwork, name string) ([]*model.Connector, error) {
+		t.Fatal("lookup must not be called")
+
+		return nil, nil
+	}
+
+	cases := []struct {
+		importer *schema.ResourceImporter
+		importID string
+	}{
+		{
+			importer: importByPrefix[*model.RemoteNetwork]("remote network", importPrefixName, failingLookup),
+			importID: "UmVtb3RlTmV0d29yazox",
+		},
+		{
+			importer: importByPrefix[*model.RemoteNetwork]("remote network", importPrefixName, failingLookup),
+			importID: "email:test@example.com",
+		},
+		{
+			importer: importByParent[*model.RemoteNetwork, *model.Connector]("connector", failingLookup, failingChildLookup),
+			importID: "Q29ubmVjdG9yOjE=",
+		},
+	}
+
+	for n, c := range cases {
+		t.Run(fmt.Sprintf("case_%d", n), func(t *testing.T) {
+			data := RemoteNetwork().Data(nil)
+			data.SetId(c.importID)
+
+			result, err := c.importer.StateContext(context.Background(), data, &client.Client{})
+
+			assert.NoError(t, err)
+			assert.Len(t, result, 1)
+			assert.Equal(t, c.importID, result[0].Id())
+		})
+	}
+}
@@ -4,12 +4,12 @@ import (
 	"context"
 	"errors"
 	"fmt"
-	"log"
 	"strings"
 
 	"github.com/Twingate/terraform-provider-twingate/twingate/internal/attr"
 	"github.com/Twingate/terraform-provider-twingate/twingate/internal/client"
 	"github.com/Twingate/terraform-provider-twingate/twingate/internal/model"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
@@ -22,6 +22,7 @@ func RemoteNetwork() *schema.Resource {
 		ReadContext:   remoteNetworkRead,
 		UpdateContext: remoteNetworkUpdate,
 		DeleteContext: remoteNetworkDelete,
+		Timeouts:      newTimeouts(defaultTimeout),
 
 		Schema: map[string]*schema.Schema{
 			attr.ID: {
@@ -41,15 +42,29 @@ func RemoteNetwork() *schema.Resource {
 				Description:  fmt.Sprintf("The location of the Remote Network. Must be one of the following: %s.", strings.Join(model.Locations, ", ")),
 				Default:      model.LocationOther,
 			},
+			attr.AdoptExisting: adoptExistingSchema("Remote Network"),
 		},
-		Importer: &schema.ResourceImporter{
-			StateContext: schema.ImportStatePassthroughContext,
-		},
+		Importer: importByPrefix("remote network", importPrefixName, lookupRemoteNetworksByName),
 	}
 }
 
 func remoteNetworkCreate(ctx context.Context, resourceData *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	c := meta.(*client.Client)
+
+	if isAdoptExisting(resourceData, c) {
+		adopted, diags := adoptExisting(ctx, resourceData, "remote network", resourceData.Get(attr.Name).(string),
+			func(ctx context.Context, name string) ([]*model.RemoteNetwork, error) {
+				return lookupRemoteNetworksByName(ctx, c, name)
+			})
+		if adopted {
+			return append(diags, remoteNetworkUpdate(ctx, resourceData, meta)...)
+		}
+
+		if diags.HasError() {
+			return diags
+		}
+	}
+
 	remoteNetwork, err := c.CreateRemoteNetwork(ctx, &model.RemoteNetwork{
 		Name:     resourceData.Get(attr.Name).(string),
 		Location: resourceData.Get(attr.Location).(string),
@@ -59,7 +74,7 @@ func remoteNetworkCreate(ctx context.Context, resourceData *schema.ResourceData,
 }
 
 func remoteNetworkUpdate(ctx context.Context, resourceData *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	log.Printf("[INFO] Updating remote network id %s", resourceData.Id())
+	tflog.Info(ctx, "updating remote network", map[string]interface{}{attr.ID: resourceData.Id()})
 
 	var name string
 	if resourceData.HasChange(attr.Name) {
@@ -81,10 +96,10 @@ func remoteNetworkDelete(ctx context.Context, resourceData *schema.ResourceData,
 
 	err := c.DeleteRemoteNetwork(ctx, resourceData.Id())
 	if err != nil {
-		return diag.FromErr(err)
+		return apiErrorDiagnostics(err)
 	}
 
-	log.Printf("[INFO] Deleted remote network id %s", resourceData.Id())
+	tflog.Info(ctx, "deleted remote network", map[string]interface{}{attr.ID: resourceData.Id()})
 
 	return nil
 }
@@ -98,14 +113,14 @@ func remoteNetworkRead(ctx context.Context, resourceData *schema.ResourceData, m
 
 func resourceRemoteNetworkReadHelper(resourceData *schema.ResourceData, remoteNetwork *model.RemoteNetwork, err error) diag.Diagnostics {
 	if err != nil {
-		if errors.Is(err, client.ErrGraphqlResultIsEmpty) {
+		if errors.Is(err, client.ErrNotFound) {
 			// clear state
 			resourceData.SetId("")
 
 			return nil
 		}
 
-		return diag.FromErr(err)
+		return apiErrorDiagnostics(err)
 	}
 
 	if err := resourceData.Set(attr.Name, remoteNetwork.Name); err != nil {
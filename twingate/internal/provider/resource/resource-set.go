@@ -0,0 +1,537 @@
+package resource
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/Twingate/terraform-provider-twingate/twingate/internal/attr"
+	"github.com/Twingate/terraform-provider-twingate/twingate/internal/client"
+	"github.com/Twingate/terraform-provider-twingate/twingate/internal/model"
+	"github.com/Twingate/terraform-provider-twingate/twingate/internal/utils"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/id"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+const (
+	defaultResourceSetConcurrency = 4
+	maxResourceSetConcurrency     = 50
+	// resourceSetTimeout - a set applies many Resources in one operation, so it gets more time than a single Resource.
+	resourceSetTimeout = 20 * time.Minute
+)
+
+func ResourceSet() *schema.Resource { //nolint:funlen
+	accessSchema := &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			attr.GroupIDs: {
+				Type:        schema.TypeSet,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Optional:    true,
+				Description: "List of Group IDs that will have permission to access the Resource.",
+			},
+			attr.ServiceAccountIDs: {
+				Type:        schema.TypeSet,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Optional:    true,
+				Description: "List of Service Account IDs that will have permission to access the Resource.",
+			},
+		},
+	}
+
+	entrySchema := &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			attr.Key: {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "Unique key of the Resource within the set, e.g. the ID of the host in a CMDB",
+			},
+			attr.Name: {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "The name of the Resource",
+			},
+			attr.Address: {
+				Type:             schema.TypeString,
+				Required:         true,
+				Description:      "The Resource's IP/CIDR or FQDN/DNS zone",
+				ValidateDiagFunc: validateAddress,
+				DiffSuppressFunc: addressDiff,
+			},
+			attr.CanonicalizeAddress: canonicalizeAddressSchema(),
+			attr.RemoteNetworkID: {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "Remote Network ID where the Resource lives",
+			},
+			attr.Protocols: {
+				Type:        schema.TypeList,
+				Optional:    true,
+				MaxItems:    1,
+				Description: "Restrict access to certain protocols and ports. By default or when this argument is not defined, there is no restriction, and all protocols and ports are allowed.",
+				Elem:        protocolsSchema(),
+			},
+			attr.Access: {
+				Type:        schema.TypeList,
+				Optional:    true,
+				MaxItems:    1,
+				Description: "Restrict access to certain groups or service accounts. Assignments made outside of Terraform are always overridden.",
+				Elem:        accessSchema,
+			},
+		},
+	}
+
+	return &schema.Resource{
+		Description:   "Manages many Resources as a single object. The whole set is refreshed with a single paginated API call, and Resources are created, updated and deleted per key with bounded concurrency.",
+		CreateContext: resourceSetCreate,
+		ReadContext:   resourceSetRead,
+		UpdateContext: resourceSetUpdate,
+		DeleteContext: resourceSetDelete,
+		Timeouts:      newTimeouts(resourceSetTimeout),
+		CustomizeDiff: resourceSetCustomizeDiff,
+
+		Schema: map[string]*schema.Schema{
+			attr.Resources: {
+				Type:        schema.TypeSet,
+				Required:    true,
+				MinItems:    1,
+				Description: "The Resources managed by this set, identified by a unique `key`",
+				Elem:        entrySchema,
+			},
+			attr.MaxConcurrency: {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      defaultResourceSetConcurrency,
+				ValidateFunc: validation.IntBetween(1, maxResourceSetConcurrency),
+				Description:  fmt.Sprintf("Maximum number of Resources created, updated or deleted in parallel. The default value is %d.", defaultResourceSetConcurrency),
+			},
+			attr.ResourceIDs: {
+				Type:        schema.TypeMap,
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "Map of Resource keys to the IDs of the Resources",
+			},
+			attr.ID: {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Autogenerated ID of the Resource set",
+			},
+		},
+	}
+}
+
+func resourceSetCreate(ctx context.Context, resourceData *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	c := meta.(*client.Client)
+
+	entries, err := convertResourceSetEntries(resourceData.Get(attr.Resources))
+	if err != nil {
+		return nestedAPIErrorDiagnostics(err)
+	}
+
+	ids := newResourceSetIDs(nil)
+	tasks := make([]func(ctx context.Context) error, 0, len(entries))
+
+	for key, entry := range entries {
+		key, entry := key, entry
+
+		tasks = append(tasks, func(ctx context.Context) error {
+			return ids.create(ctx, c, key, entry)
+		})
+	}
+
+	err = runResourceSetTasks(ctx, resourceData, tasks)
+
+	// keep track of created resources even if some of them failed
+	resourceData.SetId(id.UniqueId())
+
+	if setErr := resourceData.Set(attr.ResourceIDs, ids.toTerraform()); setErr != nil {
+		return ErrAttributeSet(setErr, attr.ResourceIDs)
+	}
+
+	if err != nil {
+		return nestedAPIErrorDiagnostics(err)
+	}
+
+	tflog.Info(ctx, "created resource set", map[string]interface{}{attr.ID: resourceData.Id(), "resources": len(entries)})
+
+	return resourceSetRead(ctx, resourceData, meta)
+}
+
+func resourceSetRead(ctx context.Context, resourceData *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	c := meta.(*client.Client)
+
+	resources, err := c.ReadFullResources(ctx)
+	if err != nil {
+		return nestedAPIErrorDiagnostics(err)
+	}
+
+	remote := make(map[string]*model.Resource, len(resources))
+	for _, resource := range resources {
+		remote[resource.ID] = resource
+	}
+
+	intents := resourceSetRawEntries(resourceData.Get(attr.Resources))
+	ids := convertResourceSetIDs(resourceData.Get(attr.ResourceIDs))
+	entries := make([]interface{}, 0, len(ids))
+	found := make(map[string]interface{}, len(ids))
+
+	for key, resourceID := range ids {
+		resource, ok := remote[resourceID]
+		if !ok {
+			// deleted outside of Terraform, will be created again
+			continue
+		}
+
+		entries = append(entries, resourceSetEntryToTerraform(key, resource, intents[key]))
+		found[key] = resourceID
+	}
+
+	if err := resourceData.Set(attr.Resources, entries); err != nil {
+		return ErrAttributeSet(err, attr.Resources)
+	}
+
+	if err := resourceData.Set(attr.ResourceIDs, found); err != nil {
+		return ErrAttributeSet(err, attr.ResourceIDs)
+	}
+
+	return nil
+}
+
+func resourceSetUpdate(ctx context.Context, resourceData *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	c := meta.(*client.Client)
+
+	oldRaw, newRaw := resourceData.GetChange(attr.Resources)
+	oldSet, newSet := oldRaw.(*schema.Set), newRaw.(*schema.Set)
+	oldRawEntries, newRawEntries := resourceSetRawEntries(oldSet), resourceSetRawEntries(newSet)
+
+	oldEntries, err := convertResourceSetEntries(oldSet)
+	if err != nil {
+		return nestedAPIErrorDiagnostics(err)
+	}
+
+	newEntries, err := convertResourceSetEntries(newSet)
+	if err != nil {
+		return nestedAPIErrorDiagnostics(err)
+	}
+
+	ids := newResourceSetIDs(convertResourceSetIDs(resourceData.Get(attr.ResourceIDs)))
+	tasks := make([]func(ctx context.Context) error, 0, len(newEntries))
+
+	for key, entry := range newEntries {
+		key, entry := key, entry
+		resourceID, exists := ids.get(key)
+
+		switch {
+		case !exists:
+			tasks = append(tasks, func(ctx context.Context) error {
+				return ids.create(ctx, c, key, entry)
+			})
+
+		case oldEntries[key] == nil || oldSet.F(oldRawEntries[key]) != newSet.F(newRawEntries[key]):
+			entry.ID = resourceID
+
+			tasks = append(tasks, func(ctx context.Context) error {
+				return updateResourceSetEntry(ctx, c, oldEntries[key], entry)
+			})
+		}
+	}
+
+	for key, resourceID := range ids.snapshot() {
+		if _, keep := newEntries[key]; keep {
+			continue
+		}
+
+		key, resourceID := key, resourceID
+
+		tasks = append(tasks, func(ctx context.Context) error {
+			return ids.delete(ctx, c, key, resourceID)
+		})
+	}
+
+	err = runResourceSetTasks(ctx, resourceData, tasks)
+
+	if setErr := resourceData.Set(attr.ResourceIDs, ids.toTerraform()); setErr != nil {
+		return ErrAttributeSet(setErr, attr.ResourceIDs)
+	}
+
+	if err != nil {
+		return nestedAPIErrorDiagnostics(err)
+	}
+
+	tflog.Info(ctx, "updated resource set", map[string]interface{}{attr.ID: resourceData.Id()})
+
+	return resourceSetRead(ctx, resourceData, meta)
+}
+
+func resourceSetDelete(ctx context.Context, resourceData *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	c := meta.(*client.Client)
+
+	ids := newResourceSetIDs(convertResourceSetIDs(resourceData.Get(attr.ResourceIDs)))
+	tasks := make([]func(ctx context.Context) error, 0, len(ids.ids))
+
+	for key, resourceID := range ids.snapshot() {
+		key, resourceID := key, resourceID
+
+		tasks = append(tasks, func(ctx context.Context) error {
+			return ids.delete(ctx, c, key, resourceID)
+		})
+	}
+
+	if err := runResourceSetTasks(ctx, resourceData, tasks); err != nil {
+		if setErr := resourceData.Set(attr.ResourceIDs, ids.toTerraform()); setErr != nil {
+			return ErrAttributeSet(setErr, attr.ResourceIDs)
+		}
+
+		return nestedAPIErrorDiagnostics(err)
+	}
+
+	tflog.Info(ctx, "deleted resource set", map[string]interface{}{attr.ID: resourceData.Id()})
+
+	return nil
+}
+
+func resourceSetCustomizeDiff(_ context.Context, diff *schema.ResourceDiff, _ interface{}) error {
+	set, ok := diff.Get(attr.Resources).(*schema.Set)
+	if !ok {
+		return nil
+	}
+
+	keys := make(map[string]bool, set.Len())
+
+	for _, elem := range set.List() {
+		rawMap, ok := elem.(map[string]interface{})
+		if !ok {
+			continue
+		}
+
+		key, _ := rawMap[attr.Key].(string)
+		if key == "" {
+			// unknown during plan
+			continue
+		}
+
+		if keys[key] {
+			return fmt.Errorf("%s: duplicate key %q", attr.Resources, key) //nolint:goerr113
+		}
+
+		keys[key] = true
+	}
+
+	return nil
+}
+
+// runResourceSetTasks - runs all tasks even if some of them fail, so that the IDs of the applied ones are kept.
+func runResourceSetTasks(ctx context.Context, resourceData *schema.ResourceData, tasks []func(ctx context.Context) error) error {
+	return utils.RunConcurrently(ctx, tasks, resourceData.Get(attr.MaxConcurrency).(int), false, //nolint:wrapcheck
+		func(ctx context.Context, task func(ctx context.Context) error) error {
+			return task(ctx)
+		})
+}
+
+func updateResourceSetEntry(ctx context.Context, c *client.Client, oldEntry, newEntry *model.Resource) error {
+	unlock := c.LockEntity(newEntry.ID)
+	defer unlock()
+
+	if oldEntry != nil {
+		if err := c.DeleteResourceGroups(ctx, newEntry.ID, setDifference(oldEntry.Groups, newEntry.Groups)); err != nil {
+			return err //nolint:wrapcheck
+		}
+
+		if err := c.DeleteResourceServiceAccounts(ctx, newEntry.ID, setDifference(oldEntry.ServiceAccounts, newEntry.ServiceAccounts)); err != nil {
+			return err //nolint:wrapcheck
+		}
+	}
+
+	resource, err := c.UpdateResource(ctx, newEntry)
+	if err != nil {
+		return err //nolint:wrapcheck
+	}
+
+	return c.AddResourceServiceAccountIDs(ctx, resource) //nolint:wrapcheck
+}
+
+// resourceSetIDs - keys to resource IDs mapping, safe for concurrent use.
+type resourceSetIDs struct {
+	lock sync.Mutex
+	ids  map[string]string
+}
+
+func newResourceSetIDs(ids map[string]string) *resourceSetIDs {
+	if ids == nil {
+		ids = make(map[string]string)
+	}
+
+	return &resourceSetIDs{ids: ids}
+}
+
+func (s *resourceSetIDs) get(key string) (string, bool) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	resourceID, ok := s.ids[key]
+
+	return resourceID, ok
+}
+
+func (s *resourceSetIDs) snapshot() map[string]string {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	ids := make(map[string]string, len(s.ids))
+	for key, resourceID := range s.ids {
+		ids[key] = resourceID
+	}
+
+	return ids
+}
+
+func (s *resourceSetIDs) create(ctx context.Context, c *client.Client, key string, entry *model.Resource) error {
+	resource, err := c.CreateResource(ctx, entry)
+	if err != nil {
+		return err //nolint:wrapcheck
+	}
+
+	s.lock.Lock()
+	s.ids[key] = resource.ID
+	s.lock.Unlock()
+
+	return c.AddResourceServiceAccountIDs(ctx, resource) //nolint:wrapcheck
+}
+
+func (s *resourceSetIDs) delete(ctx context.Context, c *client.Client, key, resourceID string) error {
+	if err := c.DeleteResource(ctx, resourceID); err != nil {
+		return err //nolint:wrapcheck
+	}
+
+	s.lock.Lock()
+	delete(s.ids, key)
+	s.lock.Unlock()
+
+	return nil
+}
+
+func (s *resourceSetIDs) toTerraform() map[string]interface{} {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	result := make(map[string]interface{}, len(s.ids))
+	for key, resourceID := range s.ids {
+		result[key] = resourceID
+	}
+
+	return result
+}
+
+func convertResourceSetIDs(data interface{}) map[string]string {
+	rawMap, _ := data.(map[string]interface{})
+	ids := make(map[string]string, len(rawMap))
+
+	for key, resourceID := range rawMap {
+		ids[key] = resourceID.(string)
+	}
+
+	return ids
+}
+
+func resourceSetRawEntries(data interface{}) map[string]map[string]interface{} {
+	set, ok := data.(*schema.Set)
+	if !ok {
+		return nil
+	}
+
+	entries := make(map[string]map[string]interface{}, set.Len())
+
+	for _, elem := range set.List() {
+		if rawMap, ok := elem.(map[string]interface{}); ok {
+			entries[rawMap[attr.Key].(string)] = rawMap
+		}
+	}
+
+	return entries
+}
+
+func convertResourceSetEntries(data interface{}) (map[string]*model.Resource, error) {
+	rawEntries := resourceSetRawEntries(data)
+	entries := make(map[string]*model.Resource, len(rawEntries))
+
+	for key, rawMap := range rawEntries {
+		protocols, err := convertProtocolsList(rawMap[attr.Protocols].([]interface{}))
+		if err != nil {
+			return nil, fmt.Errorf("resource %q: %w", key, err)
+		}
+
+		groups, serviceAccounts := convertResourceSetAccess(rawMap[attr.Access].([]interface{}))
+		canonicalize, _ := rawMap[attr.CanonicalizeAddress].(bool)
+
+		entries[key] = &model.Resource{
+			Name:            rawMap[attr.Name].(string),
+			Address:         convertAddress(rawMap[attr.Address].(string), canonicalize),
+			RemoteNetworkID: rawMap[attr.RemoteNetworkID].(string),
+			Protocols:       protocols,
+			Groups:          groups,
+			ServiceAccounts: serviceAccounts,
+			IsAuthoritative: true,
+		}
+	}
+
+	return entries, nil
+}
+
+func convertResourceSetAccess(rawList []interface{}) ([]string, []string) {
+	if len(rawList) == 0 || rawList[0] == nil {
+		return nil, nil
+	}
+
+	rawMap := rawList[0].(map[string]interface{})
+
+	return convertIDs(rawMap[attr.GroupIDs]), convertIDs(rawMap[attr.ServiceAccountIDs])
+}
+
+func resourceSetEntryToTerraform(key string, resource *model.Resource, intent map[string]interface{}) map[string]interface{} {
+	protocols := resource.Protocols
+	if protocols == nil {
+		protocols = model.DefaultProtocols()
+	}
+
+	address := resource.Address
+
+	var (
+		rawProtocols []interface{}
+		canonicalize bool
+	)
+
+	if intent != nil {
+		rawProtocols, _ = intent[attr.Protocols].([]interface{})
+		canonicalize, _ = intent[attr.CanonicalizeAddress].(bool)
+
+		// keep the configured notation of the canonicalized address, e.g. `2001:DB8::1` read back as `2001:db8::1`,
+		// since a changed element of the set is a diff even with the address diff suppressed
+		intentAddress, _ := intent[attr.Address].(string)
+		if canonicalize && model.CanonicalAddress(intentAddress) == model.CanonicalAddress(address) {
+			address = intentAddress
+		}
+	}
+
+	if intentProtocols, err := convertProtocolsList(rawProtocols); err == nil {
+		protocols = protocols.WithIntent(intentProtocols)
+	}
+
+	rawMap := map[string]interface{}{
+		attr.Key:                 key,
+		attr.Name:                resource.Name,
+		attr.Address:             address,
+		attr.CanonicalizeAddress: canonicalize,
+		attr.RemoteNetworkID:     resource.RemoteNetworkID,
+		attr.Access:              resource.AccessToTerraform(),
+	}
+
+	// omitted protocols block means default protocols
+	if len(rawProtocols) != 0 || !protocols.Equal(model.DefaultProtocols()) {
+		rawMap[attr.Protocols] = protocols.ToTerraform()
+	}
+
+	return rawMap
+}
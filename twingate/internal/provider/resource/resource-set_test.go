@@ -0,0 +1,159 @@
+package resource
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/Twingate/terraform-provider-twingate/twingate/internal/attr"
+	"github.com/Twingate/terraform-provider-twingate/twingate/internal/model"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/stretchr/testify/assert"
+)
+
+func resourceSetTestData(t *testing.T, entries ...interface{}) *schema.ResourceData {
+	t.Helper()
+
+	return schema.TestResourceDataRaw(t, ResourceSet().Schema, map[string]interface{}{
+		attr.Resources: entries,
+	})
+}
+
+func TestConvertResourceSetEntries(t *testing.T) {
+	t.Run("Test Twingate Resource : Convert Resource Set Entries", func(t *testing.T) {
+		data := resourceSetTestData(t,
+			map[string]interface{}{
+				attr.Key:             "web",
+				attr.Name:            "web",
+				attr.Address:         "web.example.com",
+				attr.RemoteNetworkID: "network1",
+				attr.Access: []interface{}{
+					map[string]interface{}{
+						attr.GroupIDs:          []interface{}{"group1"},
+						attr.ServiceAccountIDs: []interface{}{"account1"},
+					},
+				},
+			},
+			map[string]interface{}{
+				attr.Key:             "db",
+				attr.Name:            "db",
+				attr.Address:         "10.0.0.1",
+				attr.RemoteNetworkID: "network1",
+				attr.Protocols: []interface{}{
+					map[string]interface{}{
+						attr.AllowIcmp: false,
+						attr.TCP: []interface{}{
+							map[string]interface{}{
+								attr.Policy: model.PolicyRestricted,
+								attr.Ports:  []interface{}{"5432"},
+							},
+						},
+					},
+				},
+			},
+		)
+
+		entries, err := convertResourceSetEntries(data.Get(attr.Resources))
+
+		assert.NoError(t, err)
+		assert.Len(t, entries, 2)
+
+		web := entries["web"]
+		assert.Equal(t, "web.example.com", web.Address)
+		assert.Equal(t, []string{"group1"}, web.Groups)
+		assert.Equal(t, []string{"account1"}, web.ServiceAccounts)
+		assert.True(t, web.Protocols.Equal(model.DefaultProtocols()))
+
+		db := entries["db"]
+		assert.Nil(t, db.Groups)
+		assert.False(t, db.Protocols.AllowIcmp)
+		assert.Equal(t, model.PolicyRestricted, db.Protocols.TCP.Policy)
+		assert.Equal(t, []*model.PortRange{{Start: 5432, End: 5432}}, db.Protocols.TCP.Ports)
+	})
+}
+
+func TestResourceSetEntryToTerraform(t *testing.T) {
+	cases := []struct {
+		resource          *model.Resource
+		intent            map[string]interface{}
+		expectedAddress   string
+		expectedProtocols bool
+	}{
+		{
+			resource: &model.Resource{Name: "web", Address: "web.example.com", RemoteNetworkID: "network1"},
+		},
+		{
+			resource: &model.Resource{Name: "web", Address: "web.example.com", RemoteNetworkID: "network1", Protocols: model.DefaultProtocols()},
+		},
+		{
+			resource: &model.Resource{
+				Name: "db", Address: "10.0.0.1", RemoteNetworkID: "network1",
+				Protocols: &model.Protocols{
+					AllowIcmp: true,
+					TCP:       model.NewProtocol(model.PolicyRestricted, []*model.PortRange{{Start: 22, End: 22}}),
+					UDP:       model.NewProtocol(model.PolicyAllowAll, nil),
+				},
+			},
+			expectedProtocols: true,
+		},
+		{
+			resource: &model.Resource{Name: "web", Address: "web.example.com", RemoteNetworkID: "network1", Protocols: model.DefaultProtocols()},
+			intent: map[string]interface{}{
+				attr.Protocols: []interface{}{
+					map[string]interface{}{
+						attr.AllowIcmp: true,
+						attr.TCP:       []interface{}{},
+						attr.UDP:       []interface{}{},
+					},
+				},
+			},
+			expectedProtocols: true,
+		},
+		{
+			resource:        &model.Resource{Name: "db", Address: "2001:db8::1", RemoteNetworkID: "network1"},
+			intent:          map[string]interface{}{attr.Address: "2001:DB8:0::1", attr.CanonicalizeAddress: true},
+			expectedAddress: "2001:DB8:0::1",
+		},
+		{
+			resource:        &model.Resource{Name: "db", Address: "2001:db8::1", RemoteNetworkID: "network1"},
+			intent:          map[string]interface{}{attr.Address: "2001:DB8:0::1"},
+			expectedAddress: "2001:db8::1",
+		},
+		{
+			resource:        &model.Resource{Name: "db", Address: "2001:db8::2", RemoteNetworkID: "network1"},
+			intent:          map[string]interface{}{attr.Address: "2001:DB8:0::1", attr.CanonicalizeAddress: true},
+			expectedAddress: "2001:db8::2",
+		},
+	}
+
+	for n, c := range cases {
+		t.Run(fmt.Sprintf("case_%d", n), func(t *testing.T) {
+			actual := resourceSetEntryToTerraform("key", c.resource, c.intent)
+
+			expectedAddress := c.expectedAddress
+			if expectedAddress == "" {
+				expectedAddress = c.resource.Address
+			}
+
+			assert.Equal(t, "key", actual[attr.Key])
+			assert.Equal(t, expectedAddress, actual[attr.Address])
+
+			_, ok := actual[attr.Protocols]
+			assert.Equal(t, c.expectedProtocols, ok)
+		})
+	}
+}
+
+func TestResourceSetIDs(t *testing.T) {
+	t.Run("Test Twingate Resource : Resource Set IDs", func(t *testing.T) {
+		ids := newResourceSetIDs(convertResourceSetIDs(map[string]interface{}{"web": "resource1"}))
+
+		id, ok := ids.get("web")
+		assert.True(t, ok)
+		assert.Equal(t, "resource1", id)
+
+		_, ok = ids.get("db")
+		assert.False(t, ok)
+
+		assert.Equal(t, map[string]interface{}{"web": "resource1"}, ids.toTerraform())
+	})
+}
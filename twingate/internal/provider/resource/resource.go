@@ -4,19 +4,23 @@ import (
 	"context"
 	"errors"
 	"fmt"
-	"log"
-	"reflect"
 	"strings"
+	"time"
 
 	"github.com/Twingate/terraform-provider-twingate/twingate/internal/attr"
 	"github.com/Twingate/terraform-provider-twingate/twingate/internal/client"
 	"github.com/Twingate/terraform-provider-twingate/twingate/internal/model"
+	"github.com/Twingate/terraform-provider-twingate/twingate/internal/utils"
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 )
 
-func Resource() *schema.Resource { //nolint:funlen
+var ErrGrantOverlap = errors.New("temporary access is granted to a permanently bound group or service account")
+
+func protocolsSchema() *schema.Resource { //nolint:funlen
 	portsSchema := &schema.Resource{
 		Schema: map[string]*schema.Schema{
 			attr.Policy: {
@@ -32,13 +36,21 @@ func Resource() *schema.Resource { //nolint:funlen
 				Elem: &schema.Schema{
 					Type: schema.TypeString,
 				},
-				DiffSuppressOnRefresh: true,
-				DiffSuppressFunc:      portsNotChanged,
+			},
+			attr.Services: {
+				Type:     schema.TypeList,
+				Optional: true,
+				Description: fmt.Sprintf("List of named service presets whose ports are merged with `ports`, only allowed with the `%s` policy. Can be %s",
+					model.PolicyRestricted, utils.DocList(model.ServiceNames())),
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validation.StringInSlice(model.ServiceNames(), false),
+				},
 			},
 		},
 	}
 
-	protocolsSchema := &schema.Resource{
+	return &schema.Resource{
 		Schema: map[string]*schema.Schema{
 			attr.AllowIcmp: {
 				Type:        schema.TypeBool,
@@ -47,24 +59,22 @@ func Resource() *schema.Resource { //nolint:funlen
 				Description: "Whether to allow ICMP (ping) traffic",
 			},
 			attr.TCP: {
-				Type:                  schema.TypeList,
-				Required:              true,
-				MaxItems:              1,
-				Elem:                  portsSchema,
-				DiffSuppressOnRefresh: true,
-				DiffSuppressFunc:      protocolDiff,
+				Type:     schema.TypeList,
+				Required: true,
+				MaxItems: 1,
+				Elem:     portsSchema,
 			},
 			attr.UDP: {
-				Type:                  schema.TypeList,
-				Required:              true,
-				MaxItems:              1,
-				Elem:                  portsSchema,
-				DiffSuppressOnRefresh: true,
-				DiffSuppressFunc:      protocolDiff,
+				Type:     schema.TypeList,
+				Required: true,
+				MaxItems: 1,
+				Elem:     portsSchema,
 			},
 		},
 	}
+}
 
+func Resource() *schema.Resource { //nolint:funlen
 	accessSchema := &schema.Resource{
 		Schema: map[string]*schema.Schema{
 			attr.GroupIDs: {
@@ -72,7 +82,7 @@ func Resource() *schema.Resource { //nolint:funlen
 				Elem:         &schema.Schema{Type: schema.TypeString},
 				MinItems:     1,
 				Optional:     true,
-				AtLeastOneOf: []string{attr.Path(attr.Access, attr.ServiceAccountIDs)},
+				AtLeastOneOf: accessKeysExcept(attr.GroupIDs),
 				Description:  "List of Group IDs that will have permission to access the Resource.",
 			},
 			attr.ServiceAccountIDs: {
@@ -80,9 +90,43 @@ func Resource() *schema.Resource { //nolint:funlen
 				Elem:         &schema.Schema{Type: schema.TypeString},
 				MinItems:     1,
 				Optional:     true,
-				AtLeastOneOf: []string{attr.Path(attr.Access, attr.GroupIDs)},
+				AtLeastOneOf: accessKeysExcept(attr.ServiceAccountIDs),
 				Description:  "List of Service Account IDs that will have permission to access the Resource.",
 			},
+			attr.GroupGrant: {
+				Type:         schema.TypeSet,
+				Elem:         grantSchema(attr.GroupID, "ID of the Group that will have temporary permission to access the Resource."),
+				Optional:     true,
+				AtLeastOneOf: accessKeysExcept(attr.GroupGrant),
+				Description:  "Temporary access of a Group to the Resource. Once expired, the Group is removed from the Resource on the next apply. The Group must not be listed in `group_ids`.",
+			},
+			attr.ServiceAccountGrant: {
+				Type:         schema.TypeSet,
+				Elem:         grantSchema(attr.ServiceAccountID, "ID of the Service Account that will have temporary permission to access the Resource."),
+				Optional:     true,
+				AtLeastOneOf: accessKeysExcept(attr.ServiceAccountGrant),
+				Description:  "Temporary access of a Service Account to the Resource. Once expired, the Service Account is removed from the Resource on the next apply. The Service Account must not be listed in `service_account_ids`.",
+			},
+		},
+	}
+
+	activeGrantSchema := &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			attr.Type: {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: fmt.Sprintf("The type of the grant: `%s` or `%s`", model.GrantTypeGroup, model.GrantTypeServiceAccount),
+			},
+			attr.ID: {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The ID of the Group or Service Account",
+			},
+			attr.ExpiresAt: {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The expiration time of the grant in RFC3339 format",
+			},
 		},
 	}
 
@@ -92,6 +136,8 @@ func Resource() *schema.Resource { //nolint:funlen
 		UpdateContext: resourceUpdate,
 		ReadContext:   resourceRead,
 		DeleteContext: resourceDelete,
+		Timeouts:      newTimeouts(defaultTimeout),
+		CustomizeDiff: resourceCustomizeDiff,
 
 		Schema: map[string]*schema.Schema{
 			// required
@@ -101,10 +147,13 @@ func Resource() *schema.Resource { //nolint:funlen
 				Description: "The name of the Resource",
 			},
 			attr.Address: {
-				Type:        schema.TypeString,
-				Required:    true,
-				Description: "The Resource's IP/CIDR or FQDN/DNS zone",
+				Type:             schema.TypeString,
+				Required:         true,
+				Description:      "The Resource's IP/CIDR or FQDN/DNS zone",
+				ValidateDiagFunc: validateAddress,
+				DiffSuppressFunc: addressDiff,
 			},
+			attr.CanonicalizeAddress: canonicalizeAddressSchema(),
 			attr.RemoteNetworkID: {
 				Type:        schema.TypeString,
 				Required:    true,
@@ -118,13 +167,11 @@ func Resource() *schema.Resource { //nolint:funlen
 				Description: "Determines whether assignments in the access block will override any existing assignments. Default is `true`. If set to `false`, assignments made outside of Terraform will be ignored.",
 			},
 			attr.Protocols: {
-				Type:                  schema.TypeList,
-				Optional:              true,
-				MaxItems:              1,
-				Description:           "Restrict access to certain protocols and ports. By default or when this argument is not defined, there is no restriction, and all protocols and ports are allowed.",
-				DiffSuppressOnRefresh: true,
-				DiffSuppressFunc:      protocolsDiff,
-				Elem:                  protocolsSchema,
+				Type:        schema.TypeList,
+				Optional:    true,
+				MaxItems:    1,
+				Description: "Restrict access to certain protocols and ports. By default or when this argument is not defined, there is no restriction, and all protocols and ports are allowed.",
+				Elem:        protocolsSchema(),
 			},
 			attr.Access: {
 				Type:        schema.TypeList,
@@ -152,15 +199,19 @@ func Resource() *schema.Resource { //nolint:funlen
 				Description:      "Set a DNS alias address for the Resource. Must be a DNS-valid name string.",
 				DiffSuppressFunc: aliasDiff,
 			},
+			attr.ActiveGrants: {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "List of temporary grants which are not expired yet",
+				Elem:        activeGrantSchema,
+			},
 			attr.ID: {
 				Type:        schema.TypeString,
 				Computed:    true,
 				Description: "Autogenerated ID of the Resource, encoded in base64",
 			},
 		},
-		Importer: &schema.ResourceImporter{
-			StateContext: schema.ImportStatePassthroughContext,
-		},
+		Importer: importByParent("resource", lookupRemoteNetworksByName, lookupNetworkResourcesByName),
 	}
 }
 
@@ -169,19 +220,19 @@ func resourceCreate(ctx context.Context, resourceData *schema.ResourceData, meta
 
 	resource, err := convertResource(resourceData)
 	if err != nil {
-		return diag.FromErr(err)
+		return apiErrorDiagnostics(err)
 	}
 
 	resource, err = client.CreateResource(ctx, resource)
 	if err != nil {
-		return diag.FromErr(err)
+		return apiErrorDiagnostics(err)
 	}
 
 	if err = client.AddResourceServiceAccountIDs(ctx, resource); err != nil {
-		return diag.FromErr(err)
+		return apiErrorDiagnostics(err)
 	}
 
-	log.Printf("[INFO] Created resource %s", resource.Name)
+	tflog.Info(ctx, "created resource", map[string]interface{}{attr.ID: resource.ID, attr.Name: resource.Name})
 
 	return resourceResourceReadHelper(ctx, client, resourceData, resource, nil)
 }
@@ -191,29 +242,32 @@ func resourceUpdate(ctx context.Context, resourceData *schema.ResourceData, meta
 
 	resource, err := convertResource(resourceData)
 	if err != nil {
-		return diag.FromErr(err)
+		return apiErrorDiagnostics(err)
 	}
 
 	resource.ID = resourceData.Id()
 
+	unlock := client.LockEntity(resource.ID)
+	defer unlock()
+
 	if err = deleteResourceGroupIDs(ctx, resourceData, resource, client); err != nil {
-		return diag.FromErr(err)
+		return apiErrorDiagnostics(err)
 	}
 
 	if err = deleteResourceServiceAccountIDs(ctx, resourceData, resource, client); err != nil {
-		return diag.FromErr(err)
+		return apiErrorDiagnostics(err)
 	}
 
 	resource, err = client.UpdateResource(ctx, resource)
 	if err != nil {
-		return diag.FromErr(err)
+		return apiErrorDiagnostics(err)
 	}
 
 	if err = client.AddResourceServiceAccountIDs(ctx, resource); err != nil {
-		return diag.FromErr(err)
+		return apiErrorDiagnostics(err)
 	}
 
-	log.Printf("[INFO] Updated resource %s", resource.Name)
+	tflog.Info(ctx, "updated resource", map[string]interface{}{attr.ID: resource.ID, attr.Name: resource.Name})
 
 	return resourceResourceReadHelper(ctx, client, resourceData, resource, nil)
 }
@@ -235,30 +289,32 @@ func resourceDelete(ctx context.Context, resourceData *schema.ResourceData, meta
 
 	err := c.DeleteResource(ctx, resourceID)
 	if err != nil {
-		return diag.FromErr(err)
+		return apiErrorDiagnostics(err)
 	}
 
-	log.Printf("[INFO] Deleted resource id %s", resourceData.Id())
+	tflog.Info(ctx, "deleted resource", map[string]interface{}{attr.ID: resourceData.Id()})
 
 	return nil
 }
 
 func resourceResourceReadHelper(ctx context.Context, resourceClient *client.Client, resourceData *schema.ResourceData, resource *model.Resource, err error) diag.Diagnostics {
 	if err != nil {
-		if errors.Is(err, client.ErrGraphqlResultIsEmpty) {
+		if errors.Is(err, client.ErrNotFound) {
 			// clear state
 			resourceData.SetId("")
 
 			return nil
 		}
 
-		return diag.FromErr(err)
+		return apiErrorDiagnostics(err)
 	}
 
 	if resource.Protocols == nil {
 		resource.Protocols = model.DefaultProtocols()
 	}
 
+	resource.Protocols = protocolsWithIntent(resourceData, resource.Protocols)
+
 	if !resource.IsActive {
 		// fix set active state for the resource on `terraform apply`
 		err = resourceClient.UpdateResourceActiveState(ctx, &model.Resource{
@@ -267,23 +323,31 @@ func resourceResourceReadHelper(ctx context.Context, resourceClient *client.Clie
 		})
 
 		if err != nil {
-			return diag.FromErr(err)
+			return apiErrorDiagnostics(err)
 		}
 	}
 
 	remoteServiceAccounts, err := resourceClient.ReadResourceServiceAccounts(ctx, resource.ID)
 	if err != nil {
-		return diag.FromErr(err)
+		return apiErrorDiagnostics(err)
 	}
 
 	resource.ServiceAccounts = remoteServiceAccounts
 
+	grants := convertGrants(resourceData)
+
 	if !resource.IsAuthoritative {
 		groups, serviceAccounts := convertAccess(resourceData)
+		// expired grants are still managed, so they're reported as drift until removed
+		groups = append(groups, model.GrantIDs(grants, model.GrantTypeGroup)...)
+		serviceAccounts = append(serviceAccounts, model.GrantIDs(grants, model.GrantTypeServiceAccount)...)
+
 		resource.ServiceAccounts = setIntersection(serviceAccounts, resource.ServiceAccounts)
 		resource.Groups = setIntersection(groups, resource.Groups)
 	}
 
+	resource.ReconcileGrants(grants, time.Now())
+
 	resourceData.SetId(resource.ID)
 
 	return readDiagnostics(resourceData, resource)
@@ -310,6 +374,10 @@ func readDiagnostics(resourceData *schema.ResourceData, resource *model.Resource
 		return ErrAttributeSet(err, attr.Access)
 	}
 
+	if err := resourceData.Set(attr.ActiveGrants, resource.ActiveGrantsToTerraform(time.Now())); err != nil {
+		return ErrAttributeSet(err, attr.ActiveGrants)
+	}
+
 	if err := resourceData.Set(attr.Protocols, resource.Protocols.ToTerraform()); err != nil {
 		return ErrAttributeSet(err, attr.Protocols)
 	}
@@ -338,98 +406,75 @@ func readDiagnostics(resourceData *schema.ResourceData, resource *model.Resource
 	return nil
 }
 
-func protocolDiff(attribute, oldValue, newValue string, data *schema.ResourceData) bool {
-	keys := []string{
-		attr.Path(attr.Protocols, attr.TCP, attr.Policy),
-		attr.Path(attr.Protocols, attr.UDP, attr.Policy),
-	}
-
-	for _, key := range keys {
-		if strings.HasPrefix(attribute, key) {
-			oldPolicy, newPolicy := castToStrings(data.GetChange(key))
-			if oldPolicy == model.PolicyRestricted && newPolicy == model.PolicyDenyAll {
-				return true
-			}
+// protocolsWithIntent - compares the protocols read from the API with the configured ones on normalized values,
+// so the state keeps the user's notation when both grant the same access. Protocols which aren't configured
+// are left unset as long as they grant the default access.
+func protocolsWithIntent(resourceData *schema.ResourceData, protocols *model.Protocols) *model.Protocols {
+	if len(resourceData.Get(attr.Protocols).([]interface{})) == 0 {
+		if protocols.Equal(model.DefaultProtocols()) {
+			return nil
 		}
-	}
-
-	return false
-}
-
-func protocolsDiff(key, oldValue, newValue string, resourceData *schema.ResourceData) bool {
-	switch key {
-	case attr.Len(attr.Protocols),
-		attr.Len(attr.Protocols, attr.TCP),
-		attr.Len(attr.Protocols, attr.UDP):
-		return oldValue == "1" && newValue == "0"
-
-	case attr.Path(attr.Protocols, attr.TCP, attr.Policy),
-		attr.Path(attr.Protocols, attr.UDP, attr.Policy):
-		oldPolicy, newPolicy := castToStrings(resourceData.GetChange(key))
-
-		return oldPolicy == newPolicy
 
-	default:
-		return false
+		return protocols
 	}
-}
 
-func aliasDiff(key, _, _ string, resourceData *schema.ResourceData) bool {
-	oldVal, newVal := castToStrings(resourceData.GetChange(key))
+	intent, err := convertProtocols(resourceData)
+	if err != nil {
+		return protocols
+	}
 
-	return oldVal == newVal
+	return protocols.WithIntent(intent)
 }
 
-func equalPorts(a, b interface{}) bool {
-	oldPorts, newPorts := a.([]interface{}), b.([]interface{})
-
-	oldPortsRange, err := convertPorts(oldPorts)
-	if err != nil {
-		return false
+func canonicalizeAddressSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeBool,
+		Optional: true,
+		Default:  false,
+		Description: "When set to `true`, the address is sent in its canonical form, e.g. `2001:db8::/32` for `2001:0DB8::/32`, " +
+			"and changing it to an equivalent form doesn't cause a diff. The default value is `false`.",
 	}
+}
 
-	newPortsRange, err := convertPorts(newPorts)
-	if err != nil {
+// addressDiff - equivalent forms of the address don't cause a diff when the sibling `canonicalize_address` is set.
+func addressDiff(key, oldValue, newValue string, resourceData *schema.ResourceData) bool {
+	if resourceData == nil {
 		return false
 	}
 
-	oldPortsMap := convertPortsRangeToMap(oldPortsRange)
-	newPortsMap := convertPortsRangeToMap(newPortsRange)
+	canonicalize, _ := resourceData.Get(strings.TrimSuffix(key, attr.Address) + attr.CanonicalizeAddress).(bool)
 
-	return reflect.DeepEqual(oldPortsMap, newPortsMap)
+	return canonicalize && model.CanonicalAddress(oldValue) == model.CanonicalAddress(newValue)
 }
 
-func convertPortsRangeToMap(portsRange []*model.PortRange) map[int]struct{} {
-	out := make(map[int]struct{})
-
-	for _, port := range portsRange {
-		if port.Start == port.End {
-			out[port.Start] = struct{}{}
+// convertAddress - the address as configured, or its canonical form if canonicalization is enabled.
+func convertAddress(address string, canonicalize bool) string {
+	if canonicalize {
+		return model.CanonicalAddress(address)
+	}
 
-			continue
-		}
+	return address
+}
 
-		for i := port.Start; i <= port.End; i++ {
-			out[i] = struct{}{}
+func validateAddress(value interface{}, path cty.Path) diag.Diagnostics {
+	if _, err := model.ParseAddress(value.(string)); err != nil {
+		return diag.Diagnostics{
+			{
+				Severity:      diag.Error,
+				Summary:       "Invalid Resource address",
+				Detail:        err.Error(),
+				AttributePath: path,
+			},
 		}
 	}
 
-	return out
+	return nil
 }
 
-func portsNotChanged(attribute, oldValue, newValue string, data *schema.ResourceData) bool {
-	keys := []string{
-		attr.Path(attr.Protocols, attr.TCP, attr.Ports),
-		attr.Path(attr.Protocols, attr.UDP, attr.Ports),
-	}
-
-	for _, key := range keys {
-		if strings.HasPrefix(attribute, key) {
-			return equalPorts(data.GetChange(key))
-		}
-	}
+func aliasDiff(key, _, _ string, resourceData *schema.ResourceData) bool {
+	oldVal, newVal := castToStrings(resourceData.GetChange(key))
 
-	return false
+	return oldVal == newVal
 }
 
 func deleteResourceGroupIDs(ctx context.Context, resourceData *schema.ResourceData, resource *model.Resource, client *client.Client) error {
@@ -468,10 +513,14 @@ func getOldIDsNonAuthoritative(resourceData *schema.ResourceData, attribute stri
 		return convertIDs(old)
 	}
 
-	if resourceData.HasChange(attr.Path(attr.Access, attribute)) {
-		old, _ := resourceData.GetChange(attr.Path(attr.Access, attribute))
+	accessKey := attr.Path(attr.Access, attribute)
+	grantKey := attr.Path(attr.Access, grantAttribute(attribute))
 
-		return convertIDs(old)
+	if resourceData.HasChanges(accessKey, grantKey) {
+		oldIDs, _ := resourceData.GetChange(accessKey)
+		oldGrants, _ := resourceData.GetChange(grantKey)
+
+		return append(convertIDs(oldIDs), convertGrantIDs(oldGrants, grantType(attribute))...)
 	}
 
 	return nil
@@ -509,7 +558,7 @@ func convertResource(data *schema.ResourceData) (*model.Resource, error) {
 	res := &model.Resource{
 		Name:            data.Get(attr.Name).(string),
 		RemoteNetworkID: data.Get(attr.RemoteNetworkID).(string),
-		Address:         data.Get(attr.Address).(string),
+		Address:         convertAddress(data.Get(attr.Address).(string), data.Get(attr.CanonicalizeAddress).(bool)),
 		Protocols:       protocols,
 		Groups:          groups,
 		ServiceAccounts: serviceAccounts,
@@ -544,6 +593,8 @@ func getOptionalString(data *schema.ResourceData, attr string) *string {
 	return result
 }
 
+// convertAccess - returns group and service account IDs which should be bound to the resource,
+// including the ones from grants which are not expired yet.
 func convertAccess(data *schema.ResourceData) ([]string, []string) {
 	rawList := data.Get(attr.Access).([]interface{})
 	if len(rawList) == 0 || rawList[0] == nil {
@@ -551,10 +602,104 @@ func convertAccess(data *schema.ResourceData) ([]string, []string) {
 	}
 
 	rawMap := rawList[0].(map[string]interface{})
+	grants := convertGrants(data)
+	now := time.Now()
 
-	return convertIDs(rawMap[attr.GroupIDs]), convertIDs(rawMap[attr.ServiceAccountIDs])
+	groups := append(convertIDs(rawMap[attr.GroupIDs]), model.ActiveGrantIDs(grants, model.GrantTypeGroup, now)...)
+	serviceAccounts := append(convertIDs(rawMap[attr.ServiceAccountIDs]), model.ActiveGrantIDs(grants, model.GrantTypeServiceAccount, now)...)
+
+	return groups, serviceAccounts
 }
 
+func convertGrants(data *schema.ResourceData) []*model.Grant {
+	rawList := data.Get(attr.Access).([]interface{})
+	if len(rawList) == 0 || rawList[0] == nil {
+		return nil
+	}
+
+	rawMap := rawList[0].(map[string]interface{})
+
+	return append(
+		convertGrantSet(rawMap[attr.GroupGrant], model.GrantTypeGroup),
+		convertGrantSet(rawMap[attr.ServiceAccountGrant], model.GrantTypeServiceAccount)...,
+	)
+}
+
+func convertGrantSet(data interface{}, grantType string) []*model.Grant {
+	set, ok := data.(*schema.Set)
+	if !ok {
+		return nil
+	}
+
+	idAttribute := attr.GroupID
+	if grantType == model.GrantTypeServiceAccount {
+		idAttribute = attr.ServiceAccountID
+	}
+
+	return utils.Map[interface{}, *model.Grant](set.List(), func(elem interface{}) *model.Grant {
+		rawMap := elem.(map[string]interface{})
+
+		return &model.Grant{
+			Type:      grantType,
+			ID:        rawMap[idAttribute].(string),
+			ExpiresAt: rawMap[attr.ExpiresAt].(string),
+		}
+	})
+}
+
+func convertGrantIDs(data interface{}, grantType string) []string {
+	return model.GrantIDs(convertGrantSet(data, grantType), grantType)
+}
+
+func grantAttribute(attribute string) string {
+	if attribute == attr.ServiceAccountIDs {
+		return attr.ServiceAccountGrant
+	}
+
+	return attr.GroupGrant
+}
+
+func grantType(attribute string) string {
+	if attribute == attr.ServiceAccountIDs {
+		return model.GrantTypeServiceAccount
+	}
+
+	return model.GrantTypeGroup
+}
+
+func grantSchema(idAttribute, description string) *schema.Resource {
+	return &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			idAttribute: {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: description,
+			},
+			attr.ExpiresAt: {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.IsRFC3339Time,
+				Description:  "The expiration time of the grant in RFC3339 format, e.g. `2024-01-31T18:00:00Z`",
+			},
+		},
+	}
+}
+
+func accessKeysExcept(attribute string) []string {
+	keys := make([]string, 0, len(accessAttributes))
+
+	for _, key := range accessAttributes {
+		if key != attribute {
+			keys = append(keys, attr.Path(attr.Access, key))
+		}
+	}
+
+	return keys
+}
+
+//nolint:gochecknoglobals
+var accessAttributes = []string{attr.GroupIDs, attr.ServiceAccountIDs, attr.GroupGrant, attr.ServiceAccountGrant}
+
 func convertAuthoritativeFlag(data *schema.ResourceData) bool {
 	flag, hasFlag := data.GetOkExists(attr.IsAuthoritative) //nolint:staticcheck
 
@@ -567,19 +712,22 @@ func convertAuthoritativeFlag(data *schema.ResourceData) bool {
 }
 
 func convertProtocols(data *schema.ResourceData) (*model.Protocols, error) {
-	rawList := data.Get(attr.Protocols).([]interface{})
-	if len(rawList) == 0 {
+	return convertProtocolsList(data.Get(attr.Protocols).([]interface{}))
+}
+
+func convertProtocolsList(rawList []interface{}) (*model.Protocols, error) {
+	if len(rawList) == 0 || rawList[0] == nil {
 		return model.DefaultProtocols(), nil
 	}
 
 	rawMap := rawList[0].(map[string]interface{})
 
-	udp, err := convertProtocol(rawMap[attr.UDP].([]interface{}))
+	udp, err := convertProtocol(rawMap[attr.UDP].([]interface{}), model.TransportUDP)
 	if err != nil {
 		return nil, err
 	}
 
-	tcp, err := convertProtocol(rawMap[attr.TCP].([]interface{}))
+	tcp, err := convertProtocol(rawMap[attr.TCP].([]interface{}), model.TransportTCP)
 	if err != nil {
 		return nil, err
 	}
@@ -591,7 +739,7 @@ func convertProtocols(data *schema.ResourceData) (*model.Protocols, error) {
 	}, nil
 }
 
-func convertProtocol(rawList []interface{}) (*model.Protocol, error) {
+func convertProtocol(rawList []interface{}, transport string) (*model.Protocol, error) {
 	if len(rawList) == 0 {
 		return nil, nil //nolint:nilnil
 	}
@@ -604,7 +752,91 @@ func convertProtocol(rawList []interface{}) (*model.Protocol, error) {
 		return nil, err
 	}
 
-	return model.NewProtocol(policy, ports), nil
+	protocol := model.NewProtocol(policy, ports)
+
+	services := convertServices(rawMap[attr.Services])
+	if len(services) == 0 {
+		return protocol, nil
+	}
+
+	servicePorts, err := validateServices(policy, transport, services)
+	if err != nil {
+		return nil, err
+	}
+
+	protocol.Services = services
+	protocol.ServicePorts = servicePorts
+
+	return protocol, nil
+}
+
+func convertServices(rawServices interface{}) []string {
+	rawList, ok := rawServices.([]interface{})
+	if !ok || len(rawList) == 0 {
+		return nil
+	}
+
+	services := make([]string, 0, len(rawList))
+
+	for _, service := range rawList {
+		if service != nil {
+			services = append(services, service.(string))
+		}
+	}
+
+	return services
+}
+
+func validateServices(policy, transport string, services []string) ([]*model.PortRange, error) {
+	if policy != model.PolicyRestricted {
+		return nil, model.NewServicesPolicyError(policy)
+	}
+
+	return model.ServicePorts(transport, services) //nolint:wrapcheck
+}
+
+// resourceCustomizeDiff - reports misused service presets and grants at plan time.
+func resourceCustomizeDiff(_ context.Context, diff *schema.ResourceDiff, _ interface{}) error {
+	if err := validateGrants(diff); err != nil {
+		return err
+	}
+
+	for _, transport := range []string{model.TransportTCP, model.TransportUDP} {
+		policy, _ := diff.Get(attr.Path(attr.Protocols, transport, attr.Policy)).(string)
+		services := utils.Filter[string](convertServices(diff.Get(attr.Path(attr.Protocols, transport, attr.Services))),
+			func(service string) bool {
+				return service != ""
+			})
+
+		// skip unknown values, they are validated on apply
+		if policy == "" || len(services) == 0 {
+			continue
+		}
+
+		if _, err := validateServices(policy, transport, services); err != nil {
+			return fmt.Errorf("%s: %w", attr.Path(attr.Protocols, transport), err)
+		}
+	}
+
+	return nil
+}
+
+// validateGrants - a group or service account can't be both permanently and temporarily bound to the resource,
+// otherwise the expired grant would remove the permanent access.
+func validateGrants(diff *schema.ResourceDiff) error {
+	for _, attribute := range []string{attr.GroupIDs, attr.ServiceAccountIDs} {
+		ids := utils.MakeLookupMap(convertIDs(diff.Get(attr.Path(attr.Access, attribute))))
+
+		for _, id := range convertGrantIDs(diff.Get(attr.Path(attr.Access, grantAttribute(attribute))), grantType(attribute)) {
+			// skip unknown values, they are validated on apply
+			if id != "" && ids[id] {
+				return fmt.Errorf("%w: %s is listed in both %s and %s",
+					ErrGrantOverlap, id, attr.Path(attr.Access, attribute), attr.Path(attr.Access, grantAttribute(attribute)))
+			}
+		}
+	}
+
+	return nil
 }
 
 func convertPorts(rawList []interface{}) ([]*model.PortRange, error) {
@@ -1,10 +1,15 @@
 package resource
 
 import (
+	"context"
+	"fmt"
 	"testing"
 
+	"github.com/Twingate/terraform-provider-twingate/twingate/internal/attr"
 	"github.com/Twingate/terraform-provider-twingate/twingate/internal/model"
+	"github.com/hashicorp/go-cty/cty"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -19,3 +24,116 @@ func TestResourceResourceReadDiagnosticsError(t *testing.T) {
 		assert.True(t, diags.HasError())
 	})
 }
+
+func TestResourceValidateAddress(t *testing.T) {
+	path := cty.GetAttrPath(attr.Address)
+
+	t.Run("Test Twingate Resource : Validate Address Ok", func(t *testing.T) {
+		assert.False(t, validateAddress("10.0.0.0/8", path).HasError())
+	})
+
+	t.Run("Test Twingate Resource : Validate Address Error", func(t *testing.T) {
+		diags := validateAddress("10.0.0.0/33", path)
+
+		assert.True(t, diags.HasError())
+		assert.Equal(t, path, diags[0].AttributePath)
+	})
+}
+
+func TestResourceAddressDiff(t *testing.T) {
+	cases := []struct {
+		canonicalize bool
+		oldValue     string
+		newValue     string
+		expected     bool
+	}{
+		{canonicalize: true, oldValue: "2001:db8::/32", newValue: "2001:0db8::/32", expected: true},
+		{canonicalize: true, oldValue: "internal.int", newValue: "Internal.int.", expected: true},
+		{canonicalize: true, oldValue: "10.0.0.0/8", newValue: "10.0.0.0/16", expected: false},
+		{canonicalize: false, oldValue: "2001:db8::/32", newValue: "2001:0db8::/32", expected: false},
+	}
+
+	for n, c := range cases {
+		t.Run(fmt.Sprintf("case_%d", n), func(t *testing.T) {
+			data := schema.TestResourceDataRaw(t, Resource().Schema, map[string]interface{}{
+				attr.CanonicalizeAddress: c.canonicalize,
+			})
+
+			assert.Equal(t, c.expected, addressDiff(attr.Address, c.oldValue, c.newValue, data))
+		})
+	}
+
+	assert.False(t, addressDiff(attr.Address, "2001:db8::/32", "2001:0db8::/32", nil))
+}
+
+func TestResourceConvertAccessWithGrants(t *testing.T) {
+	t.Run("Test Twingate Resource : Convert Access With Grants", func(t *testing.T) {
+		data := schema.TestResourceDataRaw(t, Resource().Schema, map[string]interface{}{
+			attr.Access: []interface{}{
+				map[string]interface{}{
+					attr.GroupIDs: []interface{}{"group-1"},
+					attr.GroupGrant: []interface{}{
+						map[string]interface{}{attr.GroupID: "group-2", attr.ExpiresAt: "2999-01-01T00:00:00Z"},
+						map[string]interface{}{attr.GroupID: "group-3", attr.ExpiresAt: "2000-01-01T00:00:00Z"},
+					},
+					attr.ServiceAccountGrant: []interface{}{
+						map[string]interface{}{attr.ServiceAccountID: "sa-1", attr.ExpiresAt: "2999-01-01T00:00:00Z"},
+					},
+				},
+			},
+		})
+
+		groups, serviceAccounts := convertAccess(data)
+
+		assert.ElementsMatch(t, []string{"group-1", "group-2"}, groups)
+		assert.ElementsMatch(t, []string{"sa-1"}, serviceAccounts)
+		assert.Len(t, convertGrants(data), 3)
+	})
+}
+
+func TestResourceCustomizeDiffGrants(t *testing.T) {
+	cases := []struct {
+		access      map[string]interface{}
+		expectedErr error
+	}{
+		{
+			access: map[string]interface{}{
+				attr.GroupIDs:   []interface{}{"group-1"},
+				attr.GroupGrant: []interface{}{map[string]interface{}{attr.GroupID: "group-2", attr.ExpiresAt: "2999-01-01T00:00:00Z"}},
+			},
+		},
+		{
+			access: map[string]interface{}{
+				attr.GroupIDs:   []interface{}{"group-1"},
+				attr.GroupGrant: []interface{}{map[string]interface{}{attr.GroupID: "group-1", attr.ExpiresAt: "2999-01-01T00:00:00Z"}},
+			},
+			expectedErr: ErrGrantOverlap,
+		},
+		{
+			access: map[string]interface{}{
+				attr.ServiceAccountIDs:   []interface{}{"sa-1"},
+				attr.ServiceAccountGrant: []interface{}{map[string]interface{}{attr.ServiceAccountID: "sa-1", attr.ExpiresAt: "2999-01-01T00:00:00Z"}},
+			},
+			expectedErr: ErrGrantOverlap,
+		},
+	}
+
+	for n, c := range cases {
+		t.Run(fmt.Sprintf("case_%d", n), func(t *testing.T) {
+			config := terraform.NewResourceConfigRaw(map[string]interface{}{
+				attr.Name:            "resource",
+				attr.Address:         "10.0.0.1",
+				attr.RemoteNetworkID: "network",
+				attr.Access:          []interface{}{c.access},
+			})
+
+			_, err := Resource().Diff(context.Background(), nil, config, nil)
+
+			if c.expectedErr != nil {
+				assert.ErrorIs(t, err, c.expectedErr)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
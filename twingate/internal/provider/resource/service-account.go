@@ -3,11 +3,11 @@ package resource
 import (
 	"context"
 	"errors"
-	"log"
 
 	"github.com/Twingate/terraform-provider-twingate/twingate/internal/attr"
 	"github.com/Twingate/terraform-provider-twingate/twingate/internal/client"
 	"github.com/Twingate/terraform-provider-twingate/twingate/internal/model"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
@@ -19,6 +19,7 @@ func ServiceAccount() *schema.Resource {
 		ReadContext:   serviceAccountRead,
 		DeleteContext: serviceAccountDelete,
 		UpdateContext: serviceAccountUpdate,
+		Timeouts:      newTimeouts(defaultTimeout),
 
 		Schema: map[string]*schema.Schema{
 			attr.Name: {
@@ -26,6 +27,7 @@ func ServiceAccount() *schema.Resource {
 				Required:    true,
 				Description: "The name of the Service Account in Twingate",
 			},
+			attr.AdoptExisting: adoptExistingSchema("Service Account"),
 			// computed
 			attr.ID: {
 				Type:        schema.TypeString,
@@ -33,21 +35,33 @@ func ServiceAccount() *schema.Resource {
 				Description: "Autogenerated ID of the Service Account",
 			},
 		},
-		Importer: &schema.ResourceImporter{
-			StateContext: schema.ImportStatePassthroughContext,
-		},
+		Importer: importByPrefix("service account", importPrefixName, lookupServiceAccountsByName),
 	}
 }
 
 func serviceAccountCreate(ctx context.Context, resourceData *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	c := meta.(*client.Client)
 
+	if isAdoptExisting(resourceData, c) {
+		adopted, diags := adoptExisting(ctx, resourceData, "service account", resourceData.Get(attr.Name).(string),
+			func(ctx context.Context, name string) ([]*model.ServiceAccount, error) {
+				return lookupServiceAccountsByName(ctx, c, name)
+			})
+		if adopted {
+			return append(diags, serviceAccountUpdate(ctx, resourceData, meta)...)
+		}
+
+		if diags.HasError() {
+			return diags
+		}
+	}
+
 	serviceAccount, err := c.CreateServiceAccount(ctx, resourceData.Get(attr.Name).(string))
 	if err != nil {
-		return diag.FromErr(err)
+		return apiErrorDiagnostics(err)
 	}
 
-	log.Printf("[INFO] Service account %s created with id %v", serviceAccount.Name, serviceAccount.ID)
+	tflog.Info(ctx, "created service account", map[string]interface{}{attr.ID: serviceAccount.ID, attr.Name: serviceAccount.Name})
 
 	return serviceAccountReadHelper(resourceData, serviceAccount, nil)
 }
@@ -55,6 +69,9 @@ func serviceAccountCreate(ctx context.Context, resourceData *schema.ResourceData
 func serviceAccountUpdate(ctx context.Context, resourceData *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	c := meta.(*client.Client)
 
+	unlock := c.LockEntity(resourceData.Id())
+	defer unlock()
+
 	group, err := c.UpdateServiceAccount(ctx,
 		&model.ServiceAccount{
 			ID:   resourceData.Id(),
@@ -62,10 +79,10 @@ func serviceAccountUpdate(ctx context.Context, resourceData *schema.ResourceData
 		},
 	)
 	if err != nil {
-		return diag.FromErr(err)
+		return apiErrorDiagnostics(err)
 	}
 
-	log.Printf("[INFO] Updated service account id %v", group.ID)
+	tflog.Info(ctx, "updated service account", map[string]interface{}{attr.ID: group.ID})
 
 	return serviceAccountReadHelper(resourceData, group, err)
 }
@@ -75,10 +92,10 @@ func serviceAccountDelete(ctx context.Context, resourceData *schema.ResourceData
 
 	err := c.DeleteServiceAccount(ctx, resourceData.Id())
 	if err != nil {
-		return diag.FromErr(err)
+		return apiErrorDiagnostics(err)
 	}
 
-	log.Printf("[INFO] Deleted service account id %s", resourceData.Id())
+	tflog.Info(ctx, "deleted service account", map[string]interface{}{attr.ID: resourceData.Id()})
 
 	return nil
 }
@@ -92,14 +109,14 @@ func serviceAccountRead(ctx context.Context, resourceData *schema.ResourceData,
 
 func serviceAccountReadHelper(resourceData *schema.ResourceData, serviceAccount *model.ServiceAccount, err error) diag.Diagnostics {
 	if err != nil {
-		if errors.Is(err, client.ErrGraphqlResultIsEmpty) {
+		if errors.Is(err, client.ErrNotFound) {
 			// clear state
 			resourceData.SetId("")
 
 			return nil
 		}
 
-		return diag.FromErr(err)
+		return apiErrorDiagnostics(err)
 	}
 
 	if err := resourceData.Set(attr.Name, serviceAccount.Name); err != nil {
@@ -3,11 +3,11 @@ package resource
 import (
 	"context"
 	"errors"
-	"log"
 
 	"github.com/Twingate/terraform-provider-twingate/twingate/internal/attr"
 	"github.com/Twingate/terraform-provider-twingate/twingate/internal/client"
 	"github.com/Twingate/terraform-provider-twingate/twingate/internal/model"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
@@ -19,6 +19,7 @@ func ServiceKey() *schema.Resource {
 		ReadContext:   serviceKeyRead,
 		DeleteContext: serviceKeyDelete,
 		UpdateContext: serviceKeyUpdate,
+		Timeouts:      newTimeouts(defaultTimeout),
 
 		Schema: map[string]*schema.Schema{
 			attr.ServiceAccountID: {
@@ -46,9 +47,7 @@ func ServiceKey() *schema.Resource {
 				Description: "Autogenerated Service Key token. Used to configure a Twingate Client running in headless mode.",
 			},
 		},
-		Importer: &schema.ResourceImporter{
-			StateContext: schema.ImportStatePassthroughContext,
-		},
+		Importer: importByParent("service account key", lookupServiceAccountsByName, lookupServiceAccountKeysByName),
 	}
 }
 
@@ -60,13 +59,13 @@ func serviceKeyCreate(ctx context.Context, resourceData *schema.ResourceData, me
 		Name:    resourceData.Get(attr.Name).(string),
 	})
 	if err != nil {
-		return diag.FromErr(err)
+		return apiErrorDiagnostics(err)
 	}
 
-	log.Printf("[INFO] Service key %s created with id %v", serviceKey.Name, serviceKey.ID)
+	tflog.Info(ctx, "created service account key", map[string]interface{}{attr.ID: serviceKey.ID, attr.Name: serviceKey.Name})
 
 	if err := resourceData.Set(attr.Token, serviceKey.Token); err != nil {
-		return diag.FromErr(err)
+		return apiErrorDiagnostics(err)
 	}
 
 	return serviceKeyReadHelper(ctx, resourceData, serviceKey, nil, meta)
@@ -82,10 +81,10 @@ func serviceKeyUpdate(ctx context.Context, resourceData *schema.ResourceData, me
 		},
 	)
 	if err != nil {
-		return diag.FromErr(err)
+		return apiErrorDiagnostics(err)
 	}
 
-	log.Printf("[INFO] Updated service key id %v", serviceKey.ID)
+	tflog.Info(ctx, "updated service account key", map[string]interface{}{attr.ID: serviceKey.ID})
 
 	return serviceKeyReadHelper(ctx, resourceData, serviceKey, err, meta)
 }
@@ -95,22 +94,22 @@ func serviceKeyDelete(ctx context.Context, resourceData *schema.ResourceData, me
 
 	serviceKey, err := client.ReadServiceKey(ctx, resourceData.Id())
 	if err != nil {
-		return diag.FromErr(err)
+		return apiErrorDiagnostics(err)
 	}
 
 	if serviceKey.IsActive() {
 		err := client.RevokeServiceKey(ctx, resourceData.Id())
 		if err != nil {
-			return diag.FromErr(err)
+			return apiErrorDiagnostics(err)
 		}
 	}
 
 	err = client.DeleteServiceKey(ctx, resourceData.Id())
 	if err != nil {
-		return diag.FromErr(err)
+		return apiErrorDiagnostics(err)
 	}
 
-	log.Printf("[INFO] Deleted service key id %s", resourceData.Id())
+	tflog.Info(ctx, "deleted service account key", map[string]interface{}{attr.ID: resourceData.Id()})
 
 	return nil
 }
@@ -124,14 +123,14 @@ func serviceKeyRead(ctx context.Context, resourceData *schema.ResourceData, meta
 
 func serviceKeyReadHelper(ctx context.Context, resourceData *schema.ResourceData, serviceKey *model.ServiceKey, err error, meta interface{}) diag.Diagnostics {
 	if err != nil {
-		if errors.Is(err, client.ErrGraphqlResultIsEmpty) {
+		if errors.Is(err, client.ErrNotFound) {
 			// clear state
 			resourceData.SetId("")
 
 			return nil
 		}
 
-		return diag.FromErr(err)
+		return apiErrorDiagnostics(err)
 	}
 
 	if !serviceKey.IsActive() {
@@ -156,7 +155,7 @@ func reCreateServiceKey(ctx context.Context, resourceData *schema.ResourceData,
 
 	err := client.DeleteServiceKey(ctx, resourceData.Id())
 	if err != nil {
-		return diag.FromErr(err)
+		return apiErrorDiagnostics(err)
 	}
 
 	return serviceKeyCreate(ctx, resourceData, meta)
@@ -0,0 +1,32 @@
+package resource
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestResourcesHaveTimeouts(t *testing.T) {
+	resources := map[string]*schema.Resource{
+		TwingateRemoteNetwork:     RemoteNetwork(),
+		TwingateConnector:         Connector(),
+		TwingateConnectorTokens:   ConnectorTokens(),
+		TwingateGroup:             Group(),
+		TwingateResource:          Resource(),
+		TwingateResourceSet:       ResourceSet(),
+		TwingateServiceAccount:    ServiceAccount(),
+		TwingateServiceAccountKey: ServiceKey(),
+		TwingateUser:              User(),
+	}
+
+	for name, res := range resources {
+		t.Run(name, func(t *testing.T) {
+			assert.NotNil(t, res.Timeouts)
+			assert.NotNil(t, res.Timeouts.Create)
+			assert.NotNil(t, res.Timeouts.Read)
+			assert.NotNil(t, res.Timeouts.Delete)
+			assert.Equal(t, res.UpdateContext != nil, res.Timeouts.Update != nil)
+		})
+	}
+}
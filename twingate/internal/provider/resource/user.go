@@ -4,12 +4,12 @@ import (
 	"context"
 	"errors"
 	"fmt"
-	"log"
 
 	"github.com/Twingate/terraform-provider-twingate/twingate/internal/attr"
 	"github.com/Twingate/terraform-provider-twingate/twingate/internal/client"
 	"github.com/Twingate/terraform-provider-twingate/twingate/internal/model"
 	"github.com/Twingate/terraform-provider-twingate/twingate/internal/utils"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
@@ -24,6 +24,7 @@ func User() *schema.Resource { //nolint:funlen
 		ReadContext:   userRead,
 		DeleteContext: userDelete,
 		UpdateContext: userUpdate,
+		Timeouts:      newTimeouts(defaultTimeout),
 		Schema: map[string]*schema.Schema{
 			attr.Email: {
 				Type:        schema.TypeString,
@@ -75,9 +76,7 @@ func User() *schema.Resource { //nolint:funlen
 				Description: "Autogenerated ID of the User, encoded in base64.",
 			},
 		},
-		Importer: &schema.ResourceImporter{
-			StateContext: schema.ImportStatePassthroughContext,
-		},
+		Importer: importByPrefix("user", importPrefixEmail, lookupUsersByEmail),
 	}
 }
 
@@ -86,10 +85,10 @@ func userCreate(ctx context.Context, resourceData *schema.ResourceData, meta int
 
 	user, err := client.CreateUser(ctx, convertUser(resourceData))
 	if err != nil {
-		return diag.FromErr(err)
+		return apiErrorDiagnostics(err)
 	}
 
-	log.Printf("[INFO] User %s created with id %v", user.Email, user.ID)
+	tflog.Info(ctx, "created user", map[string]interface{}{attr.ID: user.ID, attr.Email: user.Email})
 
 	return resourceUserReadHelper(resourceData, user, nil)
 }
@@ -99,15 +98,15 @@ func userUpdate(ctx context.Context, resourceData *schema.ResourceData, meta int
 
 	err := isAllowedToChangeUser(resourceData)
 	if err != nil {
-		return diag.FromErr(err)
+		return apiErrorDiagnostics(err)
 	}
 
 	user, err := client.UpdateUser(ctx, convertUserUpdate(resourceData))
 	if err != nil {
-		return diag.FromErr(err)
+		return apiErrorDiagnostics(err)
 	}
 
-	log.Printf("[INFO] Updated user id %v", user.ID)
+	tflog.Info(ctx, "updated user", map[string]interface{}{attr.ID: user.ID})
 
 	return resourceUserReadHelper(resourceData, user, err)
 }
@@ -117,14 +116,14 @@ func userDelete(ctx context.Context, resourceData *schema.ResourceData, meta int
 
 	err := isAllowedToChangeUser(resourceData)
 	if err != nil {
-		return diag.FromErr(err)
+		return apiErrorDiagnostics(err)
 	}
 
 	if err := client.DeleteUser(ctx, resourceData.Id()); err != nil {
-		return diag.FromErr(err)
+		return apiErrorDiagnostics(err)
 	}
 
-	log.Printf("[INFO] Deleted user id %s", resourceData.Id())
+	tflog.Info(ctx, "deleted user", map[string]interface{}{attr.ID: resourceData.Id()})
 
 	return nil
 }
@@ -139,14 +138,14 @@ func userRead(ctx context.Context, resourceData *schema.ResourceData, meta inter
 
 func resourceUserReadHelper(resourceData *schema.ResourceData, user *model.User, err error) diag.Diagnostics {
 	if err != nil {
-		if errors.Is(err, client.ErrGraphqlResultIsEmpty) {
+		if errors.Is(err, client.ErrNotFound) {
 			// clear state
 			resourceData.SetId("")
 
 			return nil
 		}
 
-		return diag.FromErr(err)
+		return apiErrorDiagnostics(err)
 	}
 
 	resourceData.SetId(user.ID)
@@ -15,6 +15,7 @@ import (
 	"github.com/Twingate/terraform-provider-twingate/twingate/internal/model"
 	"github.com/Twingate/terraform-provider-twingate/twingate/internal/provider/resource"
 	"github.com/Twingate/terraform-provider-twingate/twingate/internal/utils"
+	"github.com/Twingate/terraform-provider-twingate/twingate/sdk/fake"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	sdk "github.com/hashicorp/terraform-plugin-testing/helper/resource"
 	"github.com/hashicorp/terraform-plugin-testing/terraform"
@@ -42,11 +43,18 @@ func ErrUsersLenMismatch(expected, actual int) error {
 	return fmt.Errorf("expected %d users, actual - %d", expected, actual) //nolint
 }
 
+// EnvFakeServer - when set, acceptance tests run against an in-memory server instead of a real network.
+const EnvFakeServer = "TWINGATE_FAKE_SERVER"
+
 var Provider *schema.Provider                                     //nolint:gochecknoglobals
 var ProviderFactories map[string]func() (*schema.Provider, error) //nolint:gochecknoglobals
 
 //nolint:gochecknoinits
 func init() {
+	if os.Getenv(EnvFakeServer) != "" {
+		useFakeServer()
+	}
+
 	Provider = twingate.Provider("test")
 
 	ProviderFactories = map[string]func() (*schema.Provider, error){
@@ -56,6 +64,21 @@ func init() {
 	}
 }
 
+// useFakeServer - starts the in-memory server, it lives as long as the test binary.
+func useFakeServer() {
+	server := fake.NewServer()
+
+	for key, value := range map[string]string{
+		twingate.EnvURL:      server.URL,
+		twingate.EnvNetwork:  fake.Network,
+		twingate.EnvAPIToken: fake.DefaultAPIToken,
+	} {
+		if err := os.Setenv(key, value); err != nil {
+			log.Fatal("failed to configure fake server", err)
+		}
+	}
+}
+
 func SetPageLimit(limit int) {
 	if err := os.Setenv(client.EnvPageLimit, fmt.Sprintf("%d", limit)); err != nil {
 		log.Fatal("failed to set page limit", err)
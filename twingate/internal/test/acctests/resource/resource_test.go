@@ -247,7 +247,7 @@ func TestAccTwingateResourceWithTcpDenyAllPolicy(t *testing.T) {
 				Config: createResourceWithTcpDenyAllPolicy(networkName, groupName, resourceName),
 				Check: acctests.ComposeTestCheckFunc(
 					acctests.CheckTwingateResourceExists(theResource),
-					sdk.TestCheckResourceAttr(theResource, tcpPolicy, model.PolicyRestricted),
+					sdk.TestCheckResourceAttr(theResource, tcpPolicy, model.PolicyDenyAll),
 				),
 			},
 			// expecting no changes - empty plan
@@ -304,7 +304,7 @@ func TestAccTwingateResourceWithUdpDenyAllPolicy(t *testing.T) {
 				Config: createResourceWithUdpDenyAllPolicy(remoteNetworkName, groupName, resourceName),
 				Check: acctests.ComposeTestCheckFunc(
 					acctests.CheckTwingateResourceExists(theResource),
-					sdk.TestCheckResourceAttr(theResource, udpPolicy, model.PolicyRestricted),
+					sdk.TestCheckResourceAttr(theResource, udpPolicy, model.PolicyDenyAll),
 				),
 			},
 			// expecting no changes - empty plan
@@ -489,8 +489,8 @@ func TestAccTwingateResourcePortReorderingCreatesNoChanges(t *testing.T) {
 				Config: createResourceWithPortRange(remoteNetworkName, resourceName, `"82-83", "80"`),
 				Check: acctests.ComposeTestCheckFunc(
 					acctests.CheckTwingateResourceExists(theResource),
-					sdk.TestCheckResourceAttr(theResource, firstTCPPort, "80"),
-					sdk.TestCheckResourceAttr(theResource, firstUDPPort, "80"),
+					sdk.TestCheckResourceAttr(theResource, firstTCPPort, "82-83"),
+					sdk.TestCheckResourceAttr(theResource, firstUDPPort, "82-83"),
 				),
 			},
 			// no changes
@@ -508,8 +508,8 @@ func TestAccTwingateResourcePortReorderingCreatesNoChanges(t *testing.T) {
 				Config: createResourceWithPortRange(remoteNetworkName, resourceName, `"82-83", "70"`),
 				Check: acctests.ComposeTestCheckFunc(
 					acctests.CheckTwingateResourceExists(theResource),
-					sdk.TestCheckResourceAttr(theResource, firstTCPPort, "70"),
-					sdk.TestCheckResourceAttr(theResource, firstUDPPort, "70"),
+					sdk.TestCheckResourceAttr(theResource, firstTCPPort, "82-83"),
+					sdk.TestCheckResourceAttr(theResource, firstUDPPort, "82-83"),
 				),
 			},
 		},
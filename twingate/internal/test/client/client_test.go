@@ -14,6 +14,8 @@ func newHTTPMockClient() *client.Client {
 
 	c := client.NewClient("twindev.com", "xxxx", "test",
 		time.Duration(1)*time.Second, 2, "test")
+	// responders are called in order, so nested pages are fetched one by one
+	c.MaxConcurrency = 1
 	httpmock.ActivateNonDefault(c.HTTPClient)
 
 	return c
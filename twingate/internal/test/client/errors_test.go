@@ -1,9 +1,16 @@
 package client
 
 import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
 	"testing"
 
 	"github.com/Twingate/terraform-provider-twingate/twingate/internal/client"
+	"github.com/Twingate/terraform-provider-twingate/twingate/internal/model"
+	"github.com/hasura/go-graphql-client"
+	"github.com/jarcoal/httpmock"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -12,3 +19,176 @@ func TestUnwrapError(t *testing.T) {
 
 	assert.Equal(t, errBadRequest, err.Unwrap())
 }
+
+func TestAPIErrorClass(t *testing.T) {
+	cases := []struct {
+		err           error
+		expectedClass error
+		expectedField string
+	}{
+		{err: errBadRequest},
+		{err: client.ErrGraphqlResultIsEmpty, expectedClass: client.ErrNotFound},
+		{err: client.NewMutationError("Resource with this name already exists"), expectedClass: client.ErrConflict},
+		{err: client.NewMutationError("You do not have permission to perform this action"), expectedClass: client.ErrPermissionDenied},
+		{err: client.NewMutationError("Remote network does not exist")},
+		{err: client.NewMutationError("Remote network not found")},
+		{err: client.NewMutationError("Address is invalid"), expectedClass: client.ErrValidation},
+		{err: client.NewMutationError("Character '_' is not allowed in alias"), expectedClass: client.ErrValidation},
+		{err: client.NewMutationError("Protocol ICMP not allowed for this resource"), expectedClass: client.ErrValidation},
+		{err: client.NewMutationError("Permission denied"), expectedClass: client.ErrPermissionDenied},
+		{err: client.NewMutationError("error_1")},
+		{err: client.NewHTTPError("/api", http.StatusForbidden, nil), expectedClass: client.ErrPermissionDenied},
+		{err: client.NewHTTPError("/api", http.StatusConflict, nil), expectedClass: client.ErrConflict},
+		{err: client.NewHTTPError("/api", http.StatusInternalServerError, nil)},
+		{err: client.NewHTTPError("/api", http.StatusNotFound, nil)},
+		{
+			err:           graphql.Errors{{Message: "Node does not exist", Extensions: map[string]interface{}{"code": "NOT_FOUND"}}},
+			expectedClass: client.ErrNotFound,
+		},
+		{
+			err: graphql.Errors{{
+				Message:    `404 Not Found; body: "not found"`,
+				Extensions: map[string]interface{}{"code": graphql.ErrRequestError},
+			}},
+		},
+		{err: graphql.Errors{{Message: "Could not resolve to a node", Extensions: map[string]interface{}{}}}},
+		{
+			err: graphql.Errors{{
+				Message:    "bad input",
+				Extensions: map[string]interface{}{"code": "BAD_USER_INPUT", "field": "remoteNetworkId"},
+			}},
+			expectedClass: client.ErrValidation,
+			expectedField: "remoteNetworkId",
+		},
+		{
+			err:           graphql.Errors{{Message: "Unauthorized", Extensions: map[string]interface{}{}}},
+			expectedClass: client.ErrPermissionDenied,
+		},
+		{
+			err: graphql.Errors{{
+				Message:    `401 Unauthorized; body: "{}"`,
+				Extensions: map[string]interface{}{"code": graphql.ErrRequestError},
+			}},
+			expectedClass: client.ErrPermissionDenied,
+		},
+		{
+			err: graphql.Errors{{
+				Message:    "problem constructing request",
+				Extensions: map[string]interface{}{"code": graphql.ErrRequestError},
+			}},
+		},
+	}
+
+	for n, c := range cases {
+		t.Run(fmt.Sprintf("case_%d", n), func(t *testing.T) {
+			err := client.NewAPIErrorWithID(c.err, "update", "resource", "id")
+
+			assert.Equal(t, c.expectedClass, err.Class)
+			assert.Equal(t, c.expectedField, err.Field)
+			assert.Equal(t, c.err, err.Unwrap())
+
+			if c.expectedClass != nil {
+				assert.ErrorIs(t, fmt.Errorf("wrapped: %w", err), c.expectedClass)
+			}
+
+			for _, class := range []error{client.ErrNotFound, client.ErrPermissionDenied, client.ErrConflict, client.ErrValidation} {
+				if class != c.expectedClass {
+					assert.False(t, errors.Is(err, class))
+				}
+			}
+		})
+	}
+}
+
+func TestClientMutationErrorIsClassified(t *testing.T) {
+	t.Run("Test Twingate Resource : Mutation Error Is Classified", func(t *testing.T) {
+		jsonResponse := `{
+		  "data": {
+		    "groupCreate": {
+		      "ok": false,
+		      "error": "Group with this name already exists"
+		    }
+		  }
+		}`
+
+		c := newHTTPMockClient()
+		defer httpmock.DeactivateAndReset()
+		httpmock.RegisterResponder("POST", c.GraphqlServerURL,
+			httpmock.NewStringResponder(http.StatusOK, jsonResponse))
+
+		group, err := c.CreateGroup(context.Background(), &model.Group{Name: "test"})
+
+		assert.Nil(t, group)
+		assert.EqualError(t, err, "failed to create group with name test: Group with this name already exists")
+		assert.ErrorIs(t, err, client.ErrConflict)
+	})
+}
+
+func TestClientQueryErrorIsClassified(t *testing.T) {
+	t.Run("Test Twingate Resource : Query Error Is Classified", func(t *testing.T) {
+		jsonResponse := `{
+		  "errors": [
+		    {
+		      "message": "Not allowed",
+		      "extensions": {
+		        "code": "FORBIDDEN"
+		      }
+		    }
+		  ]
+		}`
+
+		c := newHTTPMockClient()
+		defer httpmock.DeactivateAndReset()
+		httpmock.RegisterResponder("POST", c.GraphqlServerURL,
+			httpmock.NewStringResponder(http.StatusOK, jsonResponse))
+
+		group, err := c.ReadGroup(context.Background(), "id")
+
+		assert.Nil(t, group)
+		assert.ErrorIs(t, err, client.ErrPermissionDenied)
+	})
+}
+
+func TestClientHTTPErrorIsClassified(t *testing.T) {
+	t.Run("Test Twingate Resource : HTTP Error Is Classified", func(t *testing.T) {
+		c := newHTTPMockClient()
+		defer httpmock.DeactivateAndReset()
+		httpmock.RegisterResponder("POST", c.GraphqlServerURL,
+			httpmock.NewStringResponder(http.StatusForbidden, `{}`))
+
+		group, err := c.ReadGroup(context.Background(), "id")
+
+		assert.Nil(t, group)
+		assert.ErrorIs(t, err, client.ErrPermissionDenied)
+	})
+}
+
+func TestClientEmptyResultIsNotFound(t *testing.T) {
+	t.Run("Test Twingate Resource : Empty Result Is Not Found", func(t *testing.T) {
+		c := newHTTPMockClient()
+		defer httpmock.DeactivateAndReset()
+		httpmock.RegisterResponder("POST", c.GraphqlServerURL,
+			httpmock.NewStringResponder(http.StatusOK, `{"data": {"group": null}}`))
+
+		group, err := c.ReadGroup(context.Background(), "id")
+
+		assert.Nil(t, group)
+		assert.ErrorIs(t, err, client.ErrGraphqlResultIsEmpty)
+		assert.ErrorIs(t, err, client.ErrNotFound)
+	})
+}
+
+func TestClientHTTPNotFoundIsNotClassified(t *testing.T) {
+	t.Run("Test Twingate Resource : HTTP Not Found Is Not Classified", func(t *testing.T) {
+		c := newHTTPMockClient()
+		defer httpmock.DeactivateAndReset()
+		httpmock.RegisterResponder("POST", c.GraphqlServerURL,
+			httpmock.NewStringResponder(http.StatusNotFound, `not found`))
+
+		group, err := c.ReadGroup(context.Background(), "id")
+
+		assert.Nil(t, group)
+		assert.Error(t, err)
+		assert.False(t, errors.Is(err, client.ErrNotFound))
+	})
+}
@@ -67,6 +67,103 @@ func TestClientGroupCreateError(t *testing.T) {
 	})
 }
 
+func TestClientGroupCreateRecoveredAfterServerError(t *testing.T) {
+	t.Run("Test Twingate Resource : Create Group Recovered After Server Error", func(t *testing.T) {
+		expected := &model.Group{
+			ID:   "test-id",
+			Name: "test",
+		}
+
+		readGroupsJson := `{
+		  "data": {
+		    "groups": {
+		      "pageInfo": {
+		        "hasNextPage": false
+		      },
+		      "edges": [
+		        {
+		          "node": {
+		            "id": "test-id",
+		            "name": "test"
+		          }
+		        }
+		      ]
+		    }
+		  }
+		}`
+
+		c := newHTTPMockClient()
+		defer httpmock.DeactivateAndReset()
+		httpmock.RegisterResponder("POST", c.GraphqlServerURL,
+			MultipleResponders(
+				httpmock.NewStringResponder(http.StatusBadGateway, "bad gateway"),
+				httpmock.NewStringResponder(http.StatusOK, readGroupsJson),
+			))
+
+		group, err := c.CreateGroup(context.Background(), &model.Group{Name: "test"})
+
+		assert.NoError(t, err)
+		assert.EqualValues(t, expected, group)
+		assert.Equal(t, 2, httpmock.GetTotalCallCount())
+	})
+}
+
+func TestClientGroupCreateNotRecoveredWithManyMatches(t *testing.T) {
+	t.Run("Test Twingate Resource : Create Group Not Recovered With Many Matches", func(t *testing.T) {
+		readGroupsJson := `{
+		  "data": {
+		    "groups": {
+		      "pageInfo": {
+		        "hasNextPage": false
+		      },
+		      "edges": [
+		        {
+		          "node": {
+		            "id": "id-1",
+		            "name": "test"
+		          }
+		        },
+		        {
+		          "node": {
+		            "id": "id-2",
+		            "name": "test"
+		          }
+		        }
+		      ]
+		    }
+		  }
+		}`
+
+		c := newHTTPMockClient()
+		defer httpmock.DeactivateAndReset()
+		httpmock.RegisterResponder("POST", c.GraphqlServerURL,
+			MultipleResponders(
+				httpmock.NewStringResponder(http.StatusBadGateway, "bad gateway"),
+				httpmock.NewStringResponder(http.StatusOK, readGroupsJson),
+			))
+
+		group, err := c.CreateGroup(context.Background(), &model.Group{Name: "test"})
+
+		assert.Nil(t, group)
+		assert.ErrorContains(t, err, "failed to create group with name test")
+	})
+}
+
+func TestClientGroupCreateNotRecoveredAfterClientError(t *testing.T) {
+	t.Run("Test Twingate Resource : Create Group Not Recovered After Client Error", func(t *testing.T) {
+		c := newHTTPMockClient()
+		defer httpmock.DeactivateAndReset()
+		httpmock.RegisterResponder("POST", c.GraphqlServerURL,
+			httpmock.NewStringResponder(http.StatusBadRequest, "bad request"))
+
+		group, err := c.CreateGroup(context.Background(), &model.Group{Name: "test"})
+
+		assert.Nil(t, group)
+		assert.ErrorIs(t, err, client.ErrValidation)
+		assert.Equal(t, 1, httpmock.GetTotalCallCount())
+	})
+}
+
 func TestClientGroupCreateRequestError(t *testing.T) {
 	t.Run("Test Twingate Resource : Create Group Request Error", func(t *testing.T) {
 		c := newHTTPMockClient()
@@ -663,3 +663,163 @@ func TestClientReadRemoteNetworkWithNameOk(t *testing.T) {
 		assert.Equal(t, expected, network)
 	})
 }
+
+func TestClientNetworksReadByNameOk(t *testing.T) {
+	t.Run("Test Twingate Resource : Read Remote Networks By Name - Ok", func(t *testing.T) {
+		expected := []*model.RemoteNetwork{
+			{ID: "network1", Name: "test", Location: model.LocationAWS},
+			{ID: "network3", Name: "test", Location: model.LocationOther},
+		}
+
+		jsonResponse := `{
+		  "data": {
+		    "remoteNetworks": {
+		      "pageInfo": {
+		        "hasNextPage": false
+		      },
+		      "edges": [
+		        {
+		          "node": {
+		            "id": "network1",
+		            "name": "test",
+		            "location": "AWS"
+		          }
+		        },
+		        {
+		          "node": {
+		            "id": "network2",
+		            "name": "test-2",
+		            "location": "AWS"
+		          }
+		        },
+		        {
+		          "node": {
+		            "id": "network3",
+		            "name": "test",
+		            "location": "OTHER"
+		          }
+		        }
+		      ]
+		    }
+		  }
+		}`
+
+		client := newHTTPMockClient()
+		defer httpmock.DeactivateAndReset()
+		httpmock.RegisterResponder("POST", client.GraphqlServerURL,
+			httpmock.NewStringResponder(200, jsonResponse))
+
+		networks, err := client.ReadRemoteNetworksByName(context.Background(), "test")
+
+		assert.NoError(t, err)
+		assert.EqualValues(t, expected, networks)
+	})
+}
+
+func TestClientNetworksReadByNameRequestError(t *testing.T) {
+	t.Run("Test Twingate Resource : Read Remote Networks By Name - Request Error", func(t *testing.T) {
+		client := newHTTPMockClient()
+		defer httpmock.DeactivateAndReset()
+		httpmock.RegisterResponder("POST", client.GraphqlServerURL,
+			httpmock.NewErrorResponder(errBadRequest))
+
+		networks, err := client.ReadRemoteNetworksByName(context.Background(), "test")
+
+		assert.Nil(t, networks)
+		assert.EqualError(t, err, graphqlErr(client, "failed to read remote network with id All", errBadRequest))
+	})
+}
+
+func TestClientNetworksVisitStopsEarly(t *testing.T) {
+	t.Run("Test Twingate Resource : Visit Remote Networks - Stops Early", func(t *testing.T) {
+		response1 := `{
+		  "data": {
+		    "remoteNetworks": {
+		      "pageInfo": {
+		        "endCursor": "cur-01",
+		        "hasNextPage": true
+		      },
+		      "edges": [
+		        {
+		          "node": {
+		            "id": "network1",
+		            "name": "network1",
+		            "location": "AWS"
+		          }
+		        },
+		        {
+		          "node": {
+		            "id": "network2",
+		            "name": "network2",
+		            "location": "AWS"
+		          }
+		        }
+		      ]
+		    }
+		  }
+		}`
+
+		client := newHTTPMockClient()
+		defer httpmock.DeactivateAndReset()
+		httpmock.RegisterResponder("POST", client.GraphqlServerURL,
+			MultipleResponders(
+				httpmock.NewStringResponder(200, response1),
+				httpmock.NewErrorResponder(errBadRequest),
+			),
+		)
+
+		var visited []string
+
+		err := client.VisitRemoteNetworks(context.Background(), func(network *model.RemoteNetwork) bool {
+			visited = append(visited, network.ID)
+
+			return network.Name != "network1"
+		})
+
+		assert.NoError(t, err)
+		assert.Equal(t, []string{"network1"}, visited)
+		assert.Equal(t, 1, httpmock.GetTotalCallCount())
+	})
+}
+
+func TestClientNetworksVisitSkipsNullNodes(t *testing.T) {
+	t.Run("Test Twingate Resource : Visit Remote Networks - Skips Null Nodes", func(t *testing.T) {
+		jsonResponse := `{
+		  "data": {
+		    "remoteNetworks": {
+		      "pageInfo": {
+		        "hasNextPage": false
+		      },
+		      "edges": [
+		        {
+		          "node": null
+		        },
+		        {
+		          "node": {
+		            "id": "network1",
+		            "name": "network1",
+		            "location": "AWS"
+		          }
+		        }
+		      ]
+		    }
+		  }
+		}`
+
+		client := newHTTPMockClient()
+		defer httpmock.DeactivateAndReset()
+		httpmock.RegisterResponder("POST", client.GraphqlServerURL,
+			httpmock.NewStringResponder(200, jsonResponse))
+
+		var visited []string
+
+		err := client.VisitRemoteNetworks(context.Background(), func(network *model.RemoteNetwork) bool {
+			visited = append(visited, network.ID)
+
+			return true
+		})
+
+		assert.NoError(t, err)
+		assert.Equal(t, []string{"network1"}, visited)
+	})
+}
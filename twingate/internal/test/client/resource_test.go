@@ -130,7 +130,6 @@ func TestClientResourceReadOk(t *testing.T) {
 			},
 			Protocols: &model.Protocols{
 				UDP: &model.Protocol{
-					Ports:  []*model.PortRange{},
 					Policy: model.PolicyAllowAll,
 				},
 				TCP: &model.Protocol{
@@ -224,7 +223,6 @@ func TestClientResourceReadAllGroups(t *testing.T) {
 			IsActive: true,
 			Protocols: &model.Protocols{
 				UDP: &model.Protocol{
-					Ports:  []*model.PortRange{},
 					Policy: model.PolicyAllowAll,
 				},
 				TCP: &model.Protocol{
@@ -1007,7 +1005,6 @@ func TestClientResourcesReadByNameOk(t *testing.T) {
 					},
 					UDP: &model.Protocol{
 						Policy: model.PolicyAllowAll,
-						Ports:  []*model.PortRange{},
 					},
 				},
 				RemoteNetworkID:          "UmVtb3RlTmV0d29yazo0MDEzOQ==",
@@ -1026,7 +1023,6 @@ func TestClientResourcesReadByNameOk(t *testing.T) {
 					},
 					UDP: &model.Protocol{
 						Policy: model.PolicyAllowAll,
-						Ports:  []*model.PortRange{},
 					},
 				},
 				RemoteNetworkID:          "UmVtb3RlTmV0d29yazo0MDEzOQ==",
@@ -1045,7 +1041,6 @@ func TestClientResourcesReadByNameOk(t *testing.T) {
 					},
 					UDP: &model.Protocol{
 						Policy: model.PolicyAllowAll,
-						Ports:  []*model.PortRange{},
 					},
 				},
 				RemoteNetworkID:          "UmVtb3RlTmV0d29yazo0MDEzOQ==",
@@ -1735,3 +1730,342 @@ func TestClientAddResourceServiceAccountIDsRequestError(t *testing.T) {
 		assert.EqualError(t, err, graphqlErr(client, "failed to update service account with id id-1", errBadRequest))
 	})
 }
+
+func TestClientResourcesReadFullOk(t *testing.T) {
+	t.Run("Test Twingate Resource : Client Resources Read Full Ok", func(t *testing.T) {
+		var defaultBool bool
+
+		expected := []*model.Resource{
+			{
+				ID:                       "resource1",
+				Name:                     "resource1",
+				Address:                  "one.com",
+				RemoteNetworkID:          "network1",
+				Groups:                   []string{"group1"},
+				ServiceAccounts:          []string{"account1"},
+				IsActive:                 true,
+				IsVisible:                &defaultBool,
+				IsBrowserShortcutEnabled: &defaultBool,
+			},
+			{
+				ID:                       "resource2",
+				Name:                     "resource2",
+				Address:                  "two.com",
+				RemoteNetworkID:          "network1",
+				Groups:                   []string{"group1", "group2"},
+				IsActive:                 true,
+				IsVisible:                &defaultBool,
+				IsBrowserShortcutEnabled: &defaultBool,
+			},
+		}
+
+		resourcesJson := `{
+		  "data": {
+		    "resources": {
+		      "pageInfo": {
+		        "hasNextPage": false
+		      },
+		      "edges": [
+		        {
+		          "node": {
+		            "id": "resource1",
+		            "name": "resource1",
+		            "address": {
+		              "value": "one.com"
+		            },
+		            "remoteNetwork": {
+		              "id": "network1"
+		            },
+		            "isActive": true,
+		            "groups": {
+		              "pageInfo": {
+		                "hasNextPage": false
+		              },
+		              "edges": [
+		                {
+		                  "node": {
+		                    "id": "group1"
+		                  }
+		                }
+		              ]
+		            }
+		          }
+		        },
+		        {
+		          "node": {
+		            "id": "resource2",
+		            "name": "resource2",
+		            "address": {
+		              "value": "two.com"
+		            },
+		            "remoteNetwork": {
+		              "id": "network1"
+		            },
+		            "isActive": true,
+		            "groups": {
+		              "pageInfo": {
+		                "endCursor": "cur001",
+		                "hasNextPage": true
+		              },
+		              "edges": [
+		                {
+		                  "node": {
+		                    "id": "group1"
+		                  }
+		                }
+		              ]
+		            }
+		          }
+		        }
+		      ]
+		    }
+		  }
+		}`
+
+		groupsNextPageJson := `{
+		  "data": {
+		    "resource": {
+		      "id": "resource2",
+		      "groups": {
+		        "pageInfo": {
+		          "hasNextPage": false
+		        },
+		        "edges": [
+		          {
+		            "node": {
+		              "id": "group2"
+		            }
+		          }
+		        ]
+		      }
+		    }
+		  }
+		}`
+
+		serviceAccountsJson := `{
+		  "data": {
+		    "serviceAccounts": {
+		      "pageInfo": {
+		        "hasNextPage": false
+		      },
+		      "edges": [
+		        {
+		          "node": {
+		            "id": "account1",
+		            "name": "account1",
+		            "resources": {
+		              "pageInfo": {
+		                "hasNextPage": false
+		              },
+		              "edges": [
+		                {
+		                  "node": {
+		                    "id": "resource1",
+		                    "isActive": true
+		                  }
+		                }
+		              ]
+		            },
+		            "keys": {
+		              "pageInfo": {
+		                "hasNextPage": false
+		              },
+		              "edges": null
+		            }
+		          }
+		        }
+		      ]
+		    }
+		  }
+		}`
+
+		client := newHTTPMockClient()
+		defer httpmock.DeactivateAndReset()
+		httpmock.RegisterResponder("POST", client.GraphqlServerURL,
+			MultipleResponders(
+				httpmock.NewStringResponder(http.StatusOK, resourcesJson),
+				httpmock.NewStringResponder(http.StatusOK, groupsNextPageJson),
+				httpmock.NewStringResponder(http.StatusOK, serviceAccountsJson),
+			),
+		)
+
+		resources, err := client.ReadFullResources(context.Background())
+		assert.NoError(t, err)
+		assert.Equal(t, expected, resources)
+	})
+}
+
+func TestClientResourcesReadFullWithNullNode(t *testing.T) {
+	t.Run("Test Twingate Resource : Client Resources Read Full With Null Node", func(t *testing.T) {
+		var defaultBool bool
+
+		expected := []*model.Resource{
+			{
+				ID:                       "resource1",
+				Name:                     "resource1",
+				Address:                  "one.com",
+				RemoteNetworkID:          "network1",
+				Groups:                   []string{},
+				IsActive:                 true,
+				IsVisible:                &defaultBool,
+				IsBrowserShortcutEnabled: &defaultBool,
+			},
+		}
+
+		resourcesJson := `{
+		  "data": {
+		    "resources": {
+		      "pageInfo": {
+		        "hasNextPage": false
+		      },
+		      "edges": [
+		        {
+		          "node": null
+		        },
+		        {
+		          "node": {
+		            "id": "resource1",
+		            "name": "resource1",
+		            "address": {
+		              "value": "one.com"
+		            },
+		            "remoteNetwork": {
+		              "id": "network1"
+		            },
+		            "isActive": true,
+		            "groups": {
+		              "pageInfo": {
+		                "hasNextPage": false
+		              },
+		              "edges": []
+		            }
+		          }
+		        }
+		      ]
+		    }
+		  }
+		}`
+
+		serviceAccountsJson := `{
+		  "data": {
+		    "serviceAccounts": {
+		      "pageInfo": {
+		        "hasNextPage": false
+		      },
+		      "edges": []
+		    }
+		  }
+		}`
+
+		client := newHTTPMockClient()
+		defer httpmock.DeactivateAndReset()
+		httpmock.RegisterResponder("POST", client.GraphqlServerURL,
+			MultipleResponders(
+				httpmock.NewStringResponder(http.StatusOK, resourcesJson),
+				httpmock.NewStringResponder(http.StatusOK, serviceAccountsJson),
+			),
+		)
+
+		resources, err := client.ReadFullResources(context.Background())
+		assert.NoError(t, err)
+		assert.Equal(t, expected, resources)
+	})
+}
+
+func TestClientResourcesReadFullRequestError(t *testing.T) {
+	t.Run("Test Twingate Resource : Client Resources Read Full Request Error", func(t *testing.T) {
+		client := newHTTPMockClient()
+		defer httpmock.DeactivateAndReset()
+		httpmock.RegisterResponder("POST", client.GraphqlServerURL,
+			httpmock.NewErrorResponder(errBadRequest))
+
+		resources, err := client.ReadFullResources(context.Background())
+
+		assert.Nil(t, resources)
+		assert.EqualError(t, err, graphqlErr(client, "failed to read resource with id All", errBadRequest))
+	})
+}
+
+func TestClientResourceCreateRecoveredAfterTransportError(t *testing.T) {
+	t.Run("Test Twingate Resource : Create Resource Recovered After Transport Error", func(t *testing.T) {
+		readByNameJson := `{
+		  "data": {
+		    "resources": {
+		      "pageInfo": {
+		        "hasNextPage": false
+		      },
+		      "edges": [
+		        {
+		          "node": {
+		            "id": "resource1",
+		            "name": "test",
+		            "address": {
+		              "value": "Test.com"
+		            },
+		            "remoteNetwork": {
+		              "id": "network1"
+		            }
+		          }
+		        },
+		        {
+		          "node": {
+		            "id": "resource2",
+		            "name": "test",
+		            "address": {
+		              "value": "test.com"
+		            },
+		            "remoteNetwork": {
+		              "id": "network2"
+		            }
+		          }
+		        }
+		      ]
+		    }
+		  }
+		}`
+
+		readResourceJson := `{
+		  "data": {
+		    "resource": {
+		      "id": "resource1",
+		      "name": "test",
+		      "address": {
+		        "value": "Test.com"
+		      },
+		      "remoteNetwork": {
+		        "id": "network1"
+		      },
+		      "isActive": true,
+		      "groups": {
+		        "pageInfo": {
+		          "hasNextPage": false
+		        },
+		        "edges": []
+		      }
+		    }
+		  }
+		}`
+
+		client := newHTTPMockClient()
+		defer httpmock.DeactivateAndReset()
+		httpmock.RegisterResponder("POST", client.GraphqlServerURL,
+			MultipleResponders(
+				httpmock.NewErrorResponder(errBadRequest),
+				httpmock.NewStringResponder(http.StatusOK, readByNameJson),
+				httpmock.NewStringResponder(http.StatusOK, readResourceJson),
+			),
+		)
+
+		resource, err := client.CreateResource(context.Background(), &model.Resource{
+			Name:            "test",
+			Address:         "test.com",
+			RemoteNetworkID: "network1",
+			Groups:          []string{"group1"},
+		})
+
+		assert.NoError(t, err)
+		assert.Equal(t, "resource1", resource.ID)
+		assert.Equal(t, []string{"group1"}, resource.Groups)
+		assert.Equal(t, 3, httpmock.GetTotalCallCount())
+	})
+}
@@ -0,0 +1,121 @@
+package models
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/Twingate/terraform-provider-twingate/twingate/internal/model"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseAddress(t *testing.T) {
+	cases := []struct {
+		input       string
+		expected    *model.Address
+		expectedErr string
+	}{
+		{
+			input:    "10.0.0.1",
+			expected: &model.Address{Type: model.AddressTypeIP, Value: "10.0.0.1", Canonical: "10.0.0.1"},
+		},
+		{
+			input:    "2001:0db8::0001",
+			expected: &model.Address{Type: model.AddressTypeIP, Value: "2001:0db8::0001", Canonical: "2001:db8::1"},
+		},
+		{
+			input:    "10.0.0.0/8",
+			expected: &model.Address{Type: model.AddressTypeCIDR, Value: "10.0.0.0/8", Canonical: "10.0.0.0/8"},
+		},
+		{
+			input:    "2001:0db8::/32",
+			expected: &model.Address{Type: model.AddressTypeCIDR, Value: "2001:0db8::/32", Canonical: "2001:db8::/32"},
+		},
+		{
+			input:    "Internal.Corp.local.",
+			expected: &model.Address{Type: model.AddressTypeFQDN, Value: "Internal.Corp.local.", Canonical: "internal.corp.local"},
+		},
+		{
+			input:    "*.corp.local",
+			expected: &model.Address{Type: model.AddressTypeDNSZone, Value: "*.corp.local", Canonical: "*.corp.local"},
+		},
+		{
+			input:    "host-?.corp.local",
+			expected: &model.Address{Type: model.AddressTypeDNSZone, Value: "host-?.corp.local", Canonical: "host-?.corp.local"},
+		},
+		{
+			input:       "",
+			expectedErr: `invalid address "": address must be a non-empty string without surrounding whitespace`,
+		},
+		{
+			input:       " internal.int",
+			expectedErr: `invalid address " internal.int": address must be a non-empty string without surrounding whitespace`,
+		},
+		{
+			input:       "10.0.0.0/33",
+			expectedErr: `invalid address "10.0.0.0/33": invalid CIDR notation`,
+		},
+		{
+			input:       "10.0.0.1/8",
+			expectedErr: "invalid address \"10.0.0.1/8\": CIDR has host bits set, did you mean `10.0.0.0/8`?",
+		},
+		{
+			input:       "*.corp..local",
+			expectedErr: `invalid address "*.corp..local": DNS name contains an empty label`,
+		},
+		{
+			input:       "-host.corp.local",
+			expectedErr: `invalid address "-host.corp.local": DNS label can't start or end with a hyphen`,
+		},
+		{
+			input:       "host!.corp.local",
+			expectedErr: `invalid address "host!.corp.local": DNS name contains invalid character '!'`,
+		},
+		{
+			input:       "10.0.0.256",
+			expectedErr: `invalid address "10.0.0.256": invalid IP address`,
+		},
+		{
+			input:       "2001:db8::g",
+			expectedErr: `invalid address "2001:db8::g": invalid IP address`,
+		},
+		{
+			input:    "123",
+			expected: &model.Address{Type: model.AddressTypeFQDN, Value: "123", Canonical: "123"},
+		},
+		{
+			input:    "10.0.1",
+			expected: &model.Address{Type: model.AddressTypeFQDN, Value: "10.0.1", Canonical: "10.0.1"},
+		},
+	}
+
+	for n, c := range cases {
+		t.Run(fmt.Sprintf("case_%d", n), func(t *testing.T) {
+			actual, err := model.ParseAddress(c.input)
+
+			assert.Equal(t, c.expected, actual)
+
+			if c.expectedErr == "" {
+				assert.NoError(t, err)
+			} else {
+				assert.EqualError(t, err, c.expectedErr)
+			}
+		})
+	}
+}
+
+func TestCanonicalAddress(t *testing.T) {
+	cases := []struct {
+		input    string
+		expected string
+	}{
+		{input: "2001:0db8::/32", expected: "2001:db8::/32"},
+		{input: "Internal.INT", expected: "internal.int"},
+		{input: "10.0.0.1/8", expected: "10.0.0.1/8"},
+	}
+
+	for n, c := range cases {
+		t.Run(fmt.Sprintf("case_%d", n), func(t *testing.T) {
+			assert.Equal(t, c.expected, model.CanonicalAddress(c.input))
+		})
+	}
+}
@@ -0,0 +1,92 @@
+package models
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/Twingate/terraform-provider-twingate/twingate/internal/attr"
+	"github.com/Twingate/terraform-provider-twingate/twingate/internal/model"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGrantIsExpired(t *testing.T) {
+	now := time.Date(2024, 1, 31, 12, 0, 0, 0, time.UTC)
+
+	cases := []struct {
+		expiresAt string
+		expected  bool
+	}{
+		{expiresAt: "2024-01-31T18:00:00Z", expected: false},
+		{expiresAt: "2024-01-31T13:00:00+02:00", expected: true},
+		{expiresAt: "2024-01-31T12:00:00Z", expected: true},
+		{expiresAt: "tomorrow", expected: true},
+	}
+
+	for n, c := range cases {
+		t.Run(fmt.Sprintf("case_%d", n), func(t *testing.T) {
+			grant := &model.Grant{Type: model.GrantTypeGroup, ID: "group-1", ExpiresAt: c.expiresAt}
+
+			assert.Equal(t, c.expected, grant.IsExpired(now))
+		})
+	}
+}
+
+func TestResourceReconcileGrants(t *testing.T) {
+	now := time.Date(2024, 1, 31, 12, 0, 0, 0, time.UTC)
+	active := "2024-02-01T00:00:00Z"
+	expired := "2024-01-01T00:00:00Z"
+
+	resource := &model.Resource{
+		Groups:          []string{"group-1", "group-2", "group-3"},
+		ServiceAccounts: []string{"sa-1"},
+	}
+
+	grants := []*model.Grant{
+		// active and bound - moved out of permanent groups
+		{Type: model.GrantTypeGroup, ID: "group-2", ExpiresAt: active},
+		// expired and still bound - kept in permanent groups as drift
+		{Type: model.GrantTypeGroup, ID: "group-3", ExpiresAt: expired},
+		// active but not bound - dropped to be bound again
+		{Type: model.GrantTypeGroup, ID: "group-4", ExpiresAt: active},
+		// expired and removed - kept as is
+		{Type: model.GrantTypeServiceAccount, ID: "sa-2", ExpiresAt: expired},
+	}
+
+	resource.ReconcileGrants(grants, now)
+
+	assert.Equal(t, []string{"group-1", "group-3"}, resource.Groups)
+	assert.Equal(t, []string{"sa-1"}, resource.ServiceAccounts)
+	assert.Equal(t, []*model.Grant{grants[0], grants[1], grants[3]}, resource.Grants)
+
+	assert.Equal(t, []interface{}{
+		map[string]interface{}{
+			attr.GroupIDs:          []string{"group-1", "group-3"},
+			attr.ServiceAccountIDs: []string{"sa-1"},
+			attr.GroupGrant: []interface{}{
+				map[string]interface{}{attr.GroupID: "group-2", attr.ExpiresAt: active},
+				map[string]interface{}{attr.GroupID: "group-3", attr.ExpiresAt: expired},
+			},
+			attr.ServiceAccountGrant: []interface{}{
+				map[string]interface{}{attr.ServiceAccountID: "sa-2", attr.ExpiresAt: expired},
+			},
+		},
+	}, resource.AccessToTerraform())
+
+	assert.Equal(t, []interface{}{
+		map[string]interface{}{attr.Type: model.GrantTypeGroup, attr.ID: "group-2", attr.ExpiresAt: active},
+	}, resource.ActiveGrantsToTerraform(now))
+}
+
+func TestActiveGrantIDs(t *testing.T) {
+	now := time.Date(2024, 1, 31, 12, 0, 0, 0, time.UTC)
+	grants := []*model.Grant{
+		{Type: model.GrantTypeGroup, ID: "group-1", ExpiresAt: "2024-02-01T00:00:00Z"},
+		{Type: model.GrantTypeGroup, ID: "group-2", ExpiresAt: "2024-01-01T00:00:00Z"},
+		{Type: model.GrantTypeServiceAccount, ID: "sa-1", ExpiresAt: "2024-02-01T00:00:00Z"},
+	}
+
+	assert.Equal(t, []string{"group-1"}, model.ActiveGrantIDs(grants, model.GrantTypeGroup, now))
+	assert.Equal(t, []string{"group-1", "group-2"}, model.GrantIDs(grants, model.GrantTypeGroup))
+	assert.Equal(t, []string{"sa-1"}, model.ActiveGrantIDs(grants, model.GrantTypeServiceAccount, now))
+}
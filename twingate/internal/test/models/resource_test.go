@@ -178,7 +178,7 @@ func TestNewProtocol(t *testing.T) {
 			policy: model.PolicyDenyAll,
 			ports:  []*model.PortRange{{Start: 80, End: 80}},
 			expected: &model.Protocol{
-				Policy: model.PolicyRestricted,
+				Policy: model.PolicyDenyAll,
 			},
 		},
 		{
@@ -198,6 +198,81 @@ func TestNewProtocol(t *testing.T) {
 	}
 }
 
+func TestNormalizePorts(t *testing.T) {
+	cases := []struct {
+		ports    []*model.PortRange
+		expected []*model.PortRange
+	}{
+		{
+			ports:    nil,
+			expected: nil,
+		},
+		{
+			ports: []*model.PortRange{{Start: 80, End: 80}, {Start: 80, End: 90}, {Start: 91, End: 91}},
+			expected: []*model.PortRange{
+				{Start: 80, End: 91},
+			},
+		},
+		{
+			ports: []*model.PortRange{{Start: 443, End: 443}, {Start: 22, End: 22}, {Start: 22, End: 22}, {Start: 8000, End: 8100}, {Start: 8050, End: 8060}},
+			expected: []*model.PortRange{
+				{Start: 22, End: 22},
+				{Start: 443, End: 443},
+				{Start: 8000, End: 8100},
+			},
+		},
+	}
+
+	for n, c := range cases {
+		t.Run(fmt.Sprintf("case_%d", n), func(t *testing.T) {
+			assert.Equal(t, c.expected, model.NormalizePorts(c.ports))
+		})
+	}
+}
+
+func TestProtocolCanonical(t *testing.T) {
+	cases := []struct {
+		protocol *model.Protocol
+		expected *model.Protocol
+	}{
+		{
+			protocol: nil,
+			expected: nil,
+		},
+		{
+			protocol: &model.Protocol{Policy: model.PolicyRestricted},
+			expected: &model.Protocol{Policy: model.PolicyDenyAll},
+		},
+		{
+			protocol: &model.Protocol{Policy: model.PolicyAllowAll, Ports: []*model.PortRange{{Start: 80, End: 80}}},
+			expected: &model.Protocol{Policy: model.PolicyAllowAll},
+		},
+		{
+			protocol: &model.Protocol{Policy: model.PolicyRestricted, Ports: []*model.PortRange{{Start: 81, End: 81}, {Start: 80, End: 80}}},
+			expected: &model.Protocol{Policy: model.PolicyRestricted, Ports: []*model.PortRange{{Start: 80, End: 81}}},
+		},
+	}
+
+	for n, c := range cases {
+		t.Run(fmt.Sprintf("case_%d", n), func(t *testing.T) {
+			assert.Equal(t, c.expected, c.protocol.Canonical())
+		})
+	}
+}
+
+func TestProtocolWithIntent(t *testing.T) {
+	remote := &model.Protocol{Policy: model.PolicyRestricted, Ports: []*model.PortRange{{Start: 80, End: 91}}}
+	intent := &model.Protocol{Policy: model.PolicyRestricted, Ports: []*model.PortRange{{Start: 80, End: 80}, {Start: 80, End: 90}, {Start: 91, End: 91}}}
+	changed := &model.Protocol{Policy: model.PolicyRestricted, Ports: []*model.PortRange{{Start: 80, End: 80}}}
+	denyAll := &model.Protocol{Policy: model.PolicyDenyAll}
+
+	assert.Equal(t, intent, remote.WithIntent(intent))
+	assert.Equal(t, remote, remote.WithIntent(changed))
+	assert.Equal(t, remote, remote.WithIntent(nil))
+	assert.Equal(t, denyAll, (&model.Protocol{Policy: model.PolicyRestricted}).WithIntent(denyAll))
+	assert.Equal(t, model.PolicyRestricted, denyAll.APIPolicy())
+}
+
 func TestProtocolToTerraform(t *testing.T) {
 	var emptySlice []interface{}
 	var emptyStringSlice []string
@@ -0,0 +1,78 @@
+package models
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/Twingate/terraform-provider-twingate/twingate/internal/model"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestServicePorts(t *testing.T) {
+	cases := []struct {
+		transport   string
+		services    []string
+		expected    []*model.PortRange
+		expectedErr error
+	}{
+		{
+			transport: model.TransportTCP,
+			services:  nil,
+			expected:  nil,
+		},
+		{
+			transport: model.TransportTCP,
+			services:  []string{"ssh", "rdp", "kubernetes-api"},
+			expected: []*model.PortRange{
+				{Start: 22, End: 22},
+				{Start: 3389, End: 3389},
+				{Start: 6443, End: 6443},
+			},
+		},
+		{
+			transport: model.TransportUDP,
+			services:  []string{"dns"},
+			expected: []*model.PortRange{
+				{Start: 53, End: 53},
+			},
+		},
+		{
+			transport:   model.TransportUDP,
+			services:    []string{"ssh"},
+			expectedErr: errors.New(`service "ssh" has no UDP ports`),
+		},
+		{
+			transport:   model.TransportTCP,
+			services:    []string{"telnet"},
+			expectedErr: fmt.Errorf(`unknown service "telnet", supported services: %s`, "dns, http, https, kubernetes-api, ldap, ldaps, mongodb, mysql, postgres, rdp, redis, smb, ssh, vnc, winrm"),
+		},
+	}
+
+	for n, c := range cases {
+		t.Run(fmt.Sprintf("case_%d", n), func(t *testing.T) {
+			actual, err := model.ServicePorts(c.transport, c.services)
+
+			assert.Equal(t, c.expected, actual)
+
+			if c.expectedErr == nil {
+				assert.NoError(t, err)
+			} else {
+				assert.EqualError(t, err, c.expectedErr.Error())
+			}
+		})
+	}
+}
+
+func TestProtocolWithServices(t *testing.T) {
+	protocol := &model.Protocol{
+		Policy:       model.PolicyRestricted,
+		Ports:        []*model.PortRange{{Start: 23, End: 23}},
+		Services:     []string{"ssh"},
+		ServicePorts: []*model.PortRange{{Start: 22, End: 22}},
+	}
+
+	assert.Equal(t, []*model.PortRange{{Start: 22, End: 23}}, protocol.NormalizedPorts())
+	assert.True(t, protocol.Equal(&model.Protocol{Policy: model.PolicyRestricted, Ports: []*model.PortRange{{Start: 22, End: 23}}}))
+	assert.Equal(t, []string{"ssh"}, protocol.ToTerraform()[0].(map[string]interface{})["services"])
+}
@@ -0,0 +1,83 @@
+package tracing
+
+import (
+	"context"
+	"fmt"
+	"sync/atomic"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
+)
+
+const (
+	operationCreate = "create"
+	operationRead   = "read"
+	operationUpdate = "update"
+	operationDelete = "delete"
+)
+
+type crudFunc = func(ctx context.Context, resourceData *schema.ResourceData, meta interface{}) diag.Diagnostics
+
+// Resource - wraps the CRUD functions of a resource or data source, each call is recorded as a span
+// named after the operation and the type name, e.g. "create twingate_group".
+func Resource(typeName string, res *schema.Resource) *schema.Resource {
+	res.CreateContext = traceCRUD(typeName, operationCreate, res.CreateContext)
+	res.ReadContext = traceCRUD(typeName, operationRead, res.ReadContext)
+	res.UpdateContext = traceCRUD(typeName, operationUpdate, res.UpdateContext)
+	res.DeleteContext = traceCRUD(typeName, operationDelete, res.DeleteContext)
+
+	return res
+}
+
+// Resources - same as Resource, for all resources or data sources of the provider.
+func Resources(resources map[string]*schema.Resource) map[string]*schema.Resource {
+	for typeName, res := range resources {
+		resources[typeName] = Resource(typeName, res)
+	}
+
+	return resources
+}
+
+func traceCRUD(typeName, operation string, crud crudFunc) crudFunc {
+	if crud == nil {
+		return nil
+	}
+
+	return func(ctx context.Context, resourceData *schema.ResourceData, meta interface{}) diag.Diagnostics {
+		ctx, span := Tracer().Start(ctx, fmt.Sprintf("%s %s", operation, typeName),
+			trace.WithAttributes(EntityKey.String(typeName), OperationKey.String(operation)))
+		defer span.End()
+
+		ctx, pages := withPageCounter(ctx)
+		id := resourceData.Id()
+
+		diags := crud(ctx, resourceData, meta)
+
+		// create sets the ID, delete may clear it
+		if resourceData.Id() != "" {
+			id = resourceData.Id()
+		}
+
+		if id != "" {
+			span.SetAttributes(IDKey.String(id))
+		}
+
+		span.SetAttributes(PageCountKey.Int64(atomic.LoadInt64(pages)))
+		recordDiagnostics(span, diags)
+
+		return diags
+	}
+}
+
+func recordDiagnostics(span trace.Span, diags diag.Diagnostics) {
+	for _, d := range diags {
+		if d.Severity != diag.Error {
+			continue
+		}
+
+		span.RecordError(fmt.Errorf("%s: %s", d.Summary, d.Detail)) //nolint:goerr113
+		span.SetStatus(codes.Error, d.Summary)
+	}
+}
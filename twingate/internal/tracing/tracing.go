@@ -0,0 +1,102 @@
+package tracing
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"strings"
+	"sync/atomic"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/exporters/otlp/otlptrace/otlptracehttp"
+	"go.opentelemetry.io/otel/sdk/resource"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	semconv "go.opentelemetry.io/otel/semconv/v1.26.0"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// TracerName - instrumentation scope of all spans of the provider.
+const TracerName = "github.com/Twingate/terraform-provider-twingate"
+
+const serviceName = "terraform-provider-twingate"
+
+// Standard OpenTelemetry env vars, tracing is enabled only when an OTLP endpoint is set.
+const (
+	EnvSDKDisabled        = "OTEL_SDK_DISABLED"
+	EnvOTLPEndpoint       = "OTEL_EXPORTER_OTLP_ENDPOINT"
+	EnvOTLPTracesEndpoint = "OTEL_EXPORTER_OTLP_TRACES_ENDPOINT"
+)
+
+// Attributes of spans.
+const (
+	EntityKey     = attribute.Key("twingate.entity")
+	OperationKey  = attribute.Key("twingate.operation")
+	IDKey         = attribute.Key("twingate.id")
+	PageCountKey  = attribute.Key("twingate.page_count")
+	RetryCountKey = attribute.Key("http.retry_count")
+)
+
+// Enabled - tracing is off by default, it's turned on by setting the OTLP endpoint, and OTEL_SDK_DISABLED=true turns it off.
+func Enabled() bool {
+	if strings.EqualFold(os.Getenv(EnvSDKDisabled), "true") {
+		return false
+	}
+
+	return os.Getenv(EnvOTLPEndpoint) != "" || os.Getenv(EnvOTLPTracesEndpoint) != ""
+}
+
+// Setup - registers the global tracer provider exporting spans over OTLP/HTTP, when tracing is enabled.
+// The exporter is configured with the standard OTEL_EXPORTER_OTLP_* env vars.
+// The returned shutdown func flushes spans which are not exported yet.
+func Setup(ctx context.Context, version string) (func(context.Context) error, error) {
+	if !Enabled() {
+		return func(context.Context) error { return nil }, nil
+	}
+
+	exporter, err := otlptracehttp.New(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create OTLP trace exporter: %w", err)
+	}
+
+	res, err := resource.New(ctx,
+		resource.WithTelemetrySDK(),
+		resource.WithAttributes(semconv.ServiceName(serviceName), semconv.ServiceVersion(version)),
+		// OTEL_SERVICE_NAME and OTEL_RESOURCE_ATTRIBUTES take precedence
+		resource.WithFromEnv(),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create OpenTelemetry resource: %w", err)
+	}
+
+	provider := sdktrace.NewTracerProvider(
+		sdktrace.WithBatcher(exporter),
+		sdktrace.WithResource(res),
+	)
+	otel.SetTracerProvider(provider)
+
+	return provider.Shutdown, nil
+}
+
+// Tracer - tracer of the global provider, which doesn't record anything until Setup enables tracing.
+func Tracer() trace.Tracer {
+	return otel.Tracer(TracerName)
+}
+
+type contextKey string
+
+const contextKeyPageCounter contextKey = "page-counter"
+
+// withPageCounter - counts the pages read by the operation, including nested pages read concurrently.
+func withPageCounter(ctx context.Context) (context.Context, *int64) {
+	var pages int64
+
+	return context.WithValue(ctx, contextKeyPageCounter, &pages), &pages
+}
+
+// CountPage - adds a page read by a GraphQL query to the page count of the running operation.
+func CountPage(ctx context.Context) {
+	if pages, ok := ctx.Value(contextKeyPageCounter).(*int64); ok {
+		atomic.AddInt64(pages, 1)
+	}
+}
@@ -0,0 +1,131 @@
+package tracing
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+)
+
+func newInMemoryExporter(t *testing.T) *tracetest.InMemoryExporter {
+	t.Helper()
+
+	exporter := tracetest.NewInMemoryExporter()
+	previous := otel.GetTracerProvider()
+
+	otel.SetTracerProvider(sdktrace.NewTracerProvider(sdktrace.WithSyncer(exporter)))
+	t.Cleanup(func() {
+		otel.SetTracerProvider(previous)
+	})
+
+	return exporter
+}
+
+func spanAttributes(span tracetest.SpanStub) map[attribute.Key]attribute.Value {
+	attrs := make(map[attribute.Key]attribute.Value, len(span.Attributes))
+	for _, kv := range span.Attributes {
+		attrs[kv.Key] = kv.Value
+	}
+
+	return attrs
+}
+
+func TestEnabled(t *testing.T) {
+	cases := []struct {
+		endpoint       string
+		tracesEndpoint string
+		disabled       string
+		expected       bool
+	}{
+		{expected: false},
+		{endpoint: "http://localhost:4318", expected: true},
+		{tracesEndpoint: "http://localhost:4318/v1/traces", expected: true},
+		{endpoint: "http://localhost:4318", disabled: "true", expected: false},
+		{endpoint: "http://localhost:4318", disabled: "false", expected: true},
+	}
+
+	for n, c := range cases {
+		t.Run(fmt.Sprintf("case_%d", n), func(t *testing.T) {
+			t.Setenv(EnvOTLPEndpoint, c.endpoint)
+			t.Setenv(EnvOTLPTracesEndpoint, c.tracesEndpoint)
+			t.Setenv(EnvSDKDisabled, c.disabled)
+
+			assert.Equal(t, c.expected, Enabled())
+		})
+	}
+}
+
+func TestSetupWhenDisabled(t *testing.T) {
+	t.Setenv(EnvOTLPEndpoint, "")
+	t.Setenv(EnvOTLPTracesEndpoint, "")
+
+	previous := otel.GetTracerProvider()
+
+	shutdown, err := Setup(context.Background(), "test")
+	require.NoError(t, err)
+	assert.NoError(t, shutdown(context.Background()))
+	assert.Equal(t, previous, otel.GetTracerProvider())
+}
+
+func TestResource(t *testing.T) {
+	exporter := newInMemoryExporter(t)
+
+	res := Resource("twingate_group", &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"name": {Type: schema.TypeString, Optional: true},
+		},
+		CreateContext: func(ctx context.Context, resourceData *schema.ResourceData, meta interface{}) diag.Diagnostics {
+			CountPage(ctx)
+			CountPage(ctx)
+			resourceData.SetId("group-1")
+
+			return nil
+		},
+		ReadContext: func(ctx context.Context, resourceData *schema.ResourceData, meta interface{}) diag.Diagnostics {
+			return diag.Errorf("group not found")
+		},
+	})
+
+	assert.Nil(t, res.UpdateContext)
+	assert.Nil(t, res.DeleteContext)
+
+	resourceData := schema.TestResourceDataRaw(t, res.Schema, map[string]interface{}{})
+
+	assert.False(t, res.CreateContext(context.Background(), resourceData, nil).HasError())
+	assert.True(t, res.ReadContext(context.Background(), resourceData, nil).HasError())
+
+	spans := exporter.GetSpans()
+	require.Len(t, spans, 2)
+
+	created := spans[0]
+	assert.Equal(t, "create twingate_group", created.Name)
+	assert.Equal(t, codes.Unset, created.Status.Code)
+	assert.Equal(t, map[attribute.Key]attribute.Value{
+		EntityKey:    attribute.StringValue("twingate_group"),
+		OperationKey: attribute.StringValue("create"),
+		IDKey:        attribute.StringValue("group-1"),
+		PageCountKey: attribute.Int64Value(2),
+	}, spanAttributes(created))
+
+	read := spans[1]
+	assert.Equal(t, "read twingate_group", read.Name)
+	assert.Equal(t, codes.Error, read.Status.Code)
+	assert.Equal(t, "group not found", read.Status.Description)
+	assert.Equal(t, attribute.Int64Value(0), spanAttributes(read)[PageCountKey])
+	assert.Len(t, read.Events, 1)
+}
+
+func TestCountPageWithoutOperation(t *testing.T) {
+	assert.NotPanics(t, func() {
+		CountPage(context.Background())
+	})
+}
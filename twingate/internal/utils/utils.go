@@ -1,8 +1,13 @@
 package utils
 
 import (
+	"context"
+	"errors"
 	"fmt"
 	"strings"
+	"sync"
+
+	"golang.org/x/sync/errgroup"
 )
 
 // Map - transform giving slice of items by applying the func.
@@ -75,3 +80,47 @@ func DocList(items []string) string {
 		return fmt.Sprintf("%s or %s", strings.Join(items[:n-1], ", "), last)
 	}
 }
+
+// RunConcurrently - calls run for every item, running at most limit calls at the same time.
+// With stopOnError the first error cancels the calls which are still running and is returned,
+// otherwise all calls are completed and all their errors are returned joined.
+func RunConcurrently[T any](ctx context.Context, items []T, limit int, stopOnError bool, run func(ctx context.Context, item T) error) error {
+	if limit < 1 {
+		limit = 1
+	}
+
+	group := &errgroup.Group{}
+	if stopOnError {
+		group, ctx = errgroup.WithContext(ctx)
+	}
+
+	group.SetLimit(limit)
+
+	var (
+		lock sync.Mutex
+		errs []error
+	)
+
+	for _, item := range items {
+		item := item
+
+		group.Go(func() error {
+			err := run(ctx, item)
+			if err == nil || stopOnError {
+				return err
+			}
+
+			lock.Lock()
+			errs = append(errs, err)
+			lock.Unlock()
+
+			return nil
+		})
+	}
+
+	if err := group.Wait(); err != nil {
+		return err //nolint:wrapcheck
+	}
+
+	return errors.Join(errs...)
+}
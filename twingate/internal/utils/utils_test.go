@@ -0,0 +1,115 @@
+package utils
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+type fetchItem struct {
+	id    int
+	pages []string
+}
+
+func TestRunConcurrently(t *testing.T) {
+	cases := []struct {
+		count      int
+		limit      int
+		maxRunning int
+	}{
+		{count: 0, limit: 4, maxRunning: 4},
+		{count: 1, limit: 4, maxRunning: 4},
+		{count: 20, limit: 1, maxRunning: 1},
+		{count: 20, limit: 4, maxRunning: 4},
+		{count: 5, limit: 0, maxRunning: 1},
+	}
+
+	for n, c := range cases {
+		t.Run(fmt.Sprintf("case_%d", n), func(t *testing.T) {
+			items := make([]*fetchItem, 0, c.count)
+			for i := 0; i < c.count; i++ {
+				items = append(items, &fetchItem{id: i})
+			}
+
+			var running, maxRunning int32
+
+			err := RunConcurrently(context.Background(), items, c.limit, true, func(ctx context.Context, item *fetchItem) error {
+				current := atomic.AddInt32(&running, 1)
+				defer atomic.AddInt32(&running, -1)
+
+				for {
+					seen := atomic.LoadInt32(&maxRunning)
+					if current <= seen || atomic.CompareAndSwapInt32(&maxRunning, seen, current) {
+						break
+					}
+				}
+
+				time.Sleep(time.Millisecond)
+
+				item.pages = append(item.pages, fmt.Sprintf("page-%d", item.id))
+
+				return nil
+			})
+
+			assert.NoError(t, err)
+			assert.LessOrEqual(t, int(maxRunning), c.maxRunning)
+
+			for i, item := range items {
+				assert.Equal(t, i, item.id)
+				assert.Equal(t, []string{fmt.Sprintf("page-%d", i)}, item.pages)
+			}
+		})
+	}
+}
+
+func TestRunConcurrentlyStopsOnError(t *testing.T) {
+	items := []int{0, 1, 2, 3, 4, 5, 6, 7}
+	expectedErr := errors.New("fetch failed")
+
+	var (
+		mu       sync.Mutex
+		canceled int
+	)
+
+	err := RunConcurrently(context.Background(), items, 2, true, func(ctx context.Context, item int) error {
+		if item == 0 {
+			return expectedErr
+		}
+
+		select {
+		case <-ctx.Done():
+			mu.Lock()
+			canceled++
+			mu.Unlock()
+		case <-time.After(time.Second):
+		}
+
+		return nil
+	})
+
+	assert.ErrorIs(t, err, expectedErr)
+	assert.Positive(t, canceled)
+}
+
+func TestRunConcurrentlyReturnsAllErrors(t *testing.T) {
+	errFirst := errors.New("first failed")
+	errSecond := errors.New("second failed")
+
+	var completed int32
+
+	err := RunConcurrently(context.Background(), []error{errFirst, nil, errSecond}, 0, false, func(ctx context.Context, err error) error {
+		atomic.AddInt32(&completed, 1)
+
+		return err
+	})
+
+	assert.ErrorIs(t, err, errFirst)
+	assert.ErrorIs(t, err, errSecond)
+	assert.Equal(t, int32(3), completed)
+}
@@ -0,0 +1,41 @@
+package twingate
+
+import (
+	"context"
+
+	"github.com/Twingate/terraform-provider-twingate/twingate/internal/client"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+type crudFunc = func(ctx context.Context, resourceData *schema.ResourceData, meta interface{}) diag.Diagnostics
+
+// withLogging - sets up the log subsystems of the client for every CRUD and import call of the resources or data sources,
+// since each call gets a new context from Terraform.
+func withLogging(resources map[string]*schema.Resource) map[string]*schema.Resource {
+	for _, res := range resources {
+		res.CreateContext = logCRUD(res.CreateContext)
+		res.ReadContext = logCRUD(res.ReadContext)
+		res.UpdateContext = logCRUD(res.UpdateContext)
+		res.DeleteContext = logCRUD(res.DeleteContext)
+
+		if res.Importer != nil && res.Importer.StateContext != nil {
+			importState := res.Importer.StateContext
+			res.Importer.StateContext = func(ctx context.Context, data *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+				return importState(client.WithLogging(ctx), data, meta)
+			}
+		}
+	}
+
+	return resources
+}
+
+func logCRUD(crud crudFunc) crudFunc {
+	if crud == nil {
+		return nil
+	}
+
+	return func(ctx context.Context, resourceData *schema.ResourceData, meta interface{}) diag.Diagnostics {
+		return crud(client.WithLogging(ctx), resourceData, meta)
+	}
+}
@@ -2,6 +2,7 @@ package twingate
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"time"
 
@@ -9,37 +10,55 @@ import (
 	"github.com/Twingate/terraform-provider-twingate/twingate/internal/client"
 	"github.com/Twingate/terraform-provider-twingate/twingate/internal/provider/datasource"
 	"github.com/Twingate/terraform-provider-twingate/twingate/internal/provider/resource"
+	"github.com/Twingate/terraform-provider-twingate/twingate/internal/tracing"
+	"github.com/hashicorp/go-cty/cty"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 )
 
 const (
-	DefaultHTTPTimeout  = "10"
-	DefaultHTTPMaxRetry = "10"
-	DefaultURL          = "twingate.com"
+	DefaultHTTPTimeout        = "10"
+	DefaultHTTPMaxRetry       = "10"
+	DefaultHTTPMaxConcurrency = "4"
+	DefaultURL                = "twingate.com"
 
 	// EnvAPIToken env var for Token.
-	EnvAPIToken     = "TWINGATE_API_TOKEN" //#nosec
-	EnvNetwork      = "TWINGATE_NETWORK"
-	EnvURL          = "TWINGATE_URL"
-	EnvHTTPTimeout  = "TWINGATE_HTTP_TIMEOUT"
-	EnvHTTPMaxRetry = "TWINGATE_HTTP_MAX_RETRY"
+	EnvAPIToken           = "TWINGATE_API_TOKEN"         //#nosec
+	EnvAPITokenFile       = "TWINGATE_API_TOKEN_FILE"    //#nosec
+	EnvAPITokenCommand    = "TWINGATE_API_TOKEN_COMMAND" //#nosec
+	EnvNetwork            = "TWINGATE_NETWORK"
+	EnvURL                = "TWINGATE_URL"
+	EnvHTTPTimeout        = "TWINGATE_HTTP_TIMEOUT"
+	EnvHTTPMaxRetry       = "TWINGATE_HTTP_MAX_RETRY"
+	EnvHTTPMaxConcurrency = "TWINGATE_HTTP_MAX_CONCURRENCY"
+	EnvAdoptExisting      = "TWINGATE_ADOPT_EXISTING"
+	EnvProxyURL           = "TWINGATE_PROXY_URL"
+	EnvCACertFile         = "TWINGATE_CA_CERT_FILE"
+	EnvCACertPEM          = "TWINGATE_CA_CERT_PEM"
+	EnvInsecureSkipVerify = "TWINGATE_INSECURE_SKIP_VERIFY"
+	EnvClientCertFile     = "TWINGATE_CLIENT_CERT_FILE"
+	EnvClientKeyFile      = "TWINGATE_CLIENT_KEY_FILE"
+	EnvClientCertPEM      = "TWINGATE_CLIENT_CERT_PEM"
+	EnvClientKeyPEM       = "TWINGATE_CLIENT_KEY_PEM" //#nosec
+	EnvSkipProbe          = "TWINGATE_SKIP_CREDENTIALS_VALIDATION"
 )
 
 func Provider(version string) *schema.Provider {
 	provider := &schema.Provider{
 		Schema: providerOptions(),
-		ResourcesMap: map[string]*schema.Resource{
+		ResourcesMap: tracing.Resources(withLogging(map[string]*schema.Resource{
 			resource.TwingateRemoteNetwork:     resource.RemoteNetwork(),
 			resource.TwingateConnector:         resource.Connector(),
 			resource.TwingateConnectorTokens:   resource.ConnectorTokens(),
 			resource.TwingateGroup:             resource.Group(),
 			resource.TwingateResource:          resource.Resource(),
+			resource.TwingateResourceSet:       resource.ResourceSet(),
 			resource.TwingateServiceAccount:    resource.ServiceAccount(),
 			resource.TwingateServiceAccountKey: resource.ServiceKey(),
 			resource.TwingateUser:              resource.User(),
-		},
-		DataSourcesMap: map[string]*schema.Resource{
+		})),
+		DataSourcesMap: tracing.Resources(withLogging(map[string]*schema.Resource{
 			datasource.TwingateGroup:            datasource.Group(),
 			datasource.TwingateGroups:           datasource.Groups(),
 			datasource.TwingateRemoteNetwork:    datasource.RemoteNetwork(),
@@ -53,7 +72,7 @@ func Provider(version string) *schema.Provider {
 			datasource.TwingateServiceAccounts:  datasource.ServiceAccounts(),
 			datasource.TwingateSecurityPolicy:   datasource.SecurityPolicy(),
 			datasource.TwingateSecurityPolicies: datasource.SecurityPolicies(),
-		},
+		})),
 	}
 	provider.ConfigureContextFunc = configure(version, provider)
 
@@ -71,6 +90,25 @@ func providerOptions() map[string]*schema.Schema {
 				"from the Twingate Admin Console ([documentation](https://docs.twingate.com/docs/api-overview)).\n"+
 				"Alternatively, this can be specified using the %s environment variable.", EnvAPIToken),
 		},
+		attr.APITokenFile: {
+			Type:          schema.TypeString,
+			Optional:      true,
+			DefaultFunc:   schema.EnvDefaultFunc(EnvAPITokenFile, nil),
+			ConflictsWith: []string{attr.APIToken, attr.APITokenCommand},
+			Description: fmt.Sprintf("Path to a file containing the access key for API operations. The file is read again\n"+
+				"when the API rejects the key, so it can be rotated during a run. Mutually exclusive with `%s` and `%s`.\n"+
+				"Alternatively, this can be specified using the %s environment variable.", attr.APIToken, attr.APITokenCommand, EnvAPITokenFile),
+		},
+		attr.APITokenCommand: {
+			Type:          schema.TypeString,
+			Optional:      true,
+			DefaultFunc:   schema.EnvDefaultFunc(EnvAPITokenCommand, nil),
+			ConflictsWith: []string{attr.APIToken, attr.APITokenFile},
+			Description: fmt.Sprintf("A command printing the access key for API operations, run with the system shell.\n"+
+				"Its output is cached until the API rejects the key, then the command is run again, e.g. to get\n"+
+				"a new short-lived key from a secrets broker. Mutually exclusive with `%s` and `%s`.\n"+
+				"Alternatively, this can be specified using the %s environment variable.", attr.APIToken, attr.APITokenFile, EnvAPITokenCommand),
+		},
 		attr.Network: {
 			Type:        schema.TypeString,
 			Optional:    true,
@@ -87,7 +125,8 @@ func providerOptions() map[string]*schema.Schema {
 			Sensitive:   false,
 			DefaultFunc: schema.EnvDefaultFunc(EnvURL, DefaultURL),
 			Description: fmt.Sprintf("The default is '%s'\n"+
-				"This is optional and shouldn't be changed under normal circumstances.", DefaultURL),
+				"This is optional and shouldn't be changed under normal circumstances.\n"+
+				"A URL with a scheme, e.g. `http://127.0.0.1:8080`, is used as it is, without the network prefix.", DefaultURL),
 		},
 		attr.HTTPTimeout: {
 			Type:        schema.TypeInt,
@@ -103,25 +142,157 @@ func providerOptions() map[string]*schema.Schema {
 			Description: fmt.Sprintf("Specifies a retry limit for the http requests made. The default value is %s.\n"+
 				"Alternatively, this can be specified using the %s environment variable", DefaultHTTPMaxRetry, EnvHTTPMaxRetry),
 		},
+		attr.HTTPMaxConcurrency: {
+			Type:             schema.TypeInt,
+			Optional:         true,
+			DefaultFunc:      schema.EnvDefaultFunc(EnvHTTPMaxConcurrency, DefaultHTTPMaxConcurrency),
+			ValidateDiagFunc: validation.ToDiagFunc(validation.IntAtLeast(1)),
+			Description: fmt.Sprintf("Specifies a limit of parallel http requests made while reading nested pages of many objects,\n"+
+				"e.g. users of every group. The default value is %s.\n"+
+				"Alternatively, this can be specified using the %s environment variable", DefaultHTTPMaxConcurrency, EnvHTTPMaxConcurrency),
+		},
+		attr.AdoptExisting: {
+			Type:        schema.TypeBool,
+			Optional:    true,
+			DefaultFunc: schema.EnvDefaultFunc(EnvAdoptExisting, false),
+			Description: fmt.Sprintf("When set to `true`, creating a Group, Remote Network or Service Account takes ownership of an existing one\n"+
+				"with exactly the same name instead of creating a duplicate. Can be overridden per resource. The default value is `false`.\n"+
+				"Alternatively, this can be specified using the %s environment variable", EnvAdoptExisting),
+		},
+		attr.ProxyURL: {
+			Type:        schema.TypeString,
+			Optional:    true,
+			DefaultFunc: schema.EnvDefaultFunc(EnvProxyURL, nil),
+			Description: fmt.Sprintf("The proxy used for all requests, e.g. `http://proxy.internal:3128`. By default the proxy is taken\n"+
+				"from the HTTP_PROXY, HTTPS_PROXY and NO_PROXY environment variables.\n"+
+				"Alternatively, this can be specified using the %s environment variable", EnvProxyURL),
+		},
+		attr.CACertFile: {
+			Type:          schema.TypeString,
+			Optional:      true,
+			DefaultFunc:   schema.EnvDefaultFunc(EnvCACertFile, nil),
+			ConflictsWith: []string{attr.CACertPEM},
+			Description: fmt.Sprintf("Path to a PEM file of CA certificates trusted in addition to the system roots,\n"+
+				"e.g. the CA of a TLS intercepting proxy. Conflicts with `%s`.\n"+
+				"Alternatively, this can be specified using the %s environment variable", attr.CACertPEM, EnvCACertFile),
+		},
+		attr.CACertPEM: {
+			Type:          schema.TypeString,
+			Optional:      true,
+			DefaultFunc:   schema.EnvDefaultFunc(EnvCACertPEM, nil),
+			ConflictsWith: []string{attr.CACertFile},
+			Description: fmt.Sprintf("PEM encoded CA certificates trusted in addition to the system roots. Conflicts with `%s`.\n"+
+				"Alternatively, this can be specified using the %s environment variable", attr.CACertFile, EnvCACertPEM),
+		},
+		attr.InsecureSkipVerify: {
+			Type:        schema.TypeBool,
+			Optional:    true,
+			DefaultFunc: schema.EnvDefaultFunc(EnvInsecureSkipVerify, false),
+			Description: fmt.Sprintf("When set to `true`, the TLS certificate of the Twingate API is not verified.\n"+
+				"This makes connections insecure and is only meant for debugging. The default value is `false`.\n"+
+				"Alternatively, this can be specified using the %s environment variable", EnvInsecureSkipVerify),
+		},
+		attr.ClientCertFile: {
+			Type:          schema.TypeString,
+			Optional:      true,
+			DefaultFunc:   schema.EnvDefaultFunc(EnvClientCertFile, nil),
+			ConflictsWith: []string{attr.ClientCertPEM},
+			Description: fmt.Sprintf("Path to a PEM file of the client certificate presented to servers requiring mutual TLS,\n"+
+				"used together with `%s`. Alternatively, this can be specified using the %s environment variable", attr.ClientKeyFile, EnvClientCertFile),
+		},
+		attr.ClientKeyFile: {
+			Type:          schema.TypeString,
+			Optional:      true,
+			DefaultFunc:   schema.EnvDefaultFunc(EnvClientKeyFile, nil),
+			ConflictsWith: []string{attr.ClientKeyPEM},
+			Description: fmt.Sprintf("Path to a PEM file of the private key of the client certificate.\n"+
+				"Alternatively, this can be specified using the %s environment variable", EnvClientKeyFile),
+		},
+		attr.ClientCertPEM: {
+			Type:          schema.TypeString,
+			Optional:      true,
+			DefaultFunc:   schema.EnvDefaultFunc(EnvClientCertPEM, nil),
+			ConflictsWith: []string{attr.ClientCertFile},
+			Description: fmt.Sprintf("PEM encoded client certificate, used together with `%s`.\n"+
+				"Alternatively, this can be specified using the %s environment variable", attr.ClientKeyPEM, EnvClientCertPEM),
+		},
+		attr.ClientKeyPEM: {
+			Type:          schema.TypeString,
+			Optional:      true,
+			Sensitive:     true,
+			DefaultFunc:   schema.EnvDefaultFunc(EnvClientKeyPEM, nil),
+			ConflictsWith: []string{attr.ClientKeyFile},
+			Description: fmt.Sprintf("PEM encoded private key of the client certificate.\n"+
+				"Alternatively, this can be specified using the %s environment variable", EnvClientKeyPEM),
+		},
+		attr.SkipProbe: {
+			Type:        schema.TypeBool,
+			Optional:    true,
+			DefaultFunc: schema.EnvDefaultFunc(EnvSkipProbe, false),
+			Description: fmt.Sprintf("When set to `true`, the provider doesn't check that the network is reachable and the API token is valid\n"+
+				"while it's configured, e.g. for offline `terraform validate`. The default value is `false`.\n"+
+				"Alternatively, this can be specified using the %s environment variable", EnvSkipProbe),
+		},
 	}
 }
 
 func configure(version string, _ *schema.Provider) func(context.Context, *schema.ResourceData) (interface{}, diag.Diagnostics) {
 	return func(ctx context.Context, d *schema.ResourceData) (interface{}, diag.Diagnostics) {
+		ctx = client.WithLogging(ctx)
+
 		apiToken := d.Get(attr.APIToken).(string)
 		network := d.Get(attr.Network).(string)
 		url := d.Get(attr.URL).(string)
 		httpTimeout := d.Get(attr.HTTPTimeout).(int)
 		httpMaxRetry := d.Get(attr.HTTPMaxRetry).(int)
+		httpMaxConcurrency := d.Get(attr.HTTPMaxConcurrency).(int)
+		insecureSkipVerify := d.Get(attr.InsecureSkipVerify).(bool)
 
 		if network != "" {
-			return client.NewClient(url,
-					apiToken,
-					network,
-					time.Duration(httpTimeout)*time.Second,
-					httpMaxRetry,
-					version),
-				nil
+			c, err := client.New(network, client.Config{
+				URL:                url,
+				APIToken:           apiToken,
+				APITokenFile:       d.Get(attr.APITokenFile).(string),
+				APITokenCommand:    d.Get(attr.APITokenCommand).(string),
+				HTTPTimeout:        time.Duration(httpTimeout) * time.Second,
+				HTTPMaxRetry:       httpMaxRetry,
+				HTTPMaxConcurrency: httpMaxConcurrency,
+				Version:            version,
+				Transport: client.TransportConfig{
+					ProxyURL:           d.Get(attr.ProxyURL).(string),
+					CACertFile:         d.Get(attr.CACertFile).(string),
+					CACertPEM:          d.Get(attr.CACertPEM).(string),
+					InsecureSkipVerify: insecureSkipVerify,
+					ClientCertFile:     d.Get(attr.ClientCertFile).(string),
+					ClientKeyFile:      d.Get(attr.ClientKeyFile).(string),
+					ClientCertPEM:      d.Get(attr.ClientCertPEM).(string),
+					ClientKeyPEM:       d.Get(attr.ClientKeyPEM).(string),
+				},
+			})
+			if err != nil {
+				return nil, diag.FromErr(err)
+			}
+
+			c.AdoptExisting = d.Get(attr.AdoptExisting).(bool)
+			c.LogSettings(ctx)
+
+			var diags diag.Diagnostics
+			if insecureSkipVerify {
+				diags = append(diags, diag.Diagnostic{
+					Severity:      diag.Warning,
+					Summary:       "TLS certificate verification is disabled",
+					Detail:        "`insecure_skip_verify` is set, so the identity of the Twingate API is not verified and the API token can be intercepted. Only use it for debugging.",
+					AttributePath: cty.GetAttrPath(attr.InsecureSkipVerify),
+				})
+			}
+
+			if !d.Get(attr.SkipProbe).(bool) {
+				if err := c.Probe(ctx); err != nil {
+					return nil, append(diags, probeDiagnostic(err, c.GraphqlServerURL))
+				}
+			}
+
+			return c, diags
 		}
 
 		return nil, diag.Diagnostics{
@@ -133,3 +304,35 @@ func configure(version string, _ *schema.Provider) func(context.Context, *schema
 		}
 	}
 }
+
+// probeDiagnostic - explains which provider setting is the likely cause of a failed probe.
+func probeDiagnostic(err error, serverURL string) diag.Diagnostic {
+	diagnostic := diag.Diagnostic{
+		Severity: diag.Error,
+		Summary:  "Unable to connect to the Twingate API",
+		Detail:   fmt.Sprintf("%s.\nSet `%s` to skip this check.", err.Error(), attr.SkipProbe),
+	}
+
+	switch {
+	case errors.Is(err, client.ErrProbeDNS):
+		diagnostic.Summary = "Unable to resolve the Twingate network"
+		diagnostic.Detail = fmt.Sprintf("%s.\nCheck that `%s` and `%s` are correct, the API is expected at %s",
+			err.Error(), attr.Network, attr.URL, serverURL)
+		diagnostic.AttributePath = cty.GetAttrPath(attr.Network)
+	case errors.Is(err, client.ErrProbeTLS):
+		diagnostic.Summary = "Unable to verify the TLS certificate of the Twingate API"
+		diagnostic.Detail = fmt.Sprintf("%s.\nIf the connection goes through a TLS intercepting proxy, trust its CA with `%s` or `%s`.",
+			err.Error(), attr.CACertFile, attr.CACertPEM)
+	case errors.Is(err, client.ErrProbeUnauthorized), errors.Is(err, client.ErrAPITokenNoSet), errors.Is(err, client.ErrAPITokenUnavailable):
+		diagnostic.Summary = "Invalid Twingate API token"
+		diagnostic.Detail = fmt.Sprintf("%s.\nCheck that `%s`, `%s` or `%s` provides a valid API token for %s",
+			err.Error(), attr.APIToken, attr.APITokenFile, attr.APITokenCommand, serverURL)
+		diagnostic.AttributePath = cty.GetAttrPath(attr.APIToken)
+	case errors.Is(err, client.ErrProbeForbidden):
+		diagnostic.Summary = "Insufficient permissions of the Twingate API token"
+		diagnostic.Detail = fmt.Sprintf("%s.\nThe API token needs at least read permission.", err.Error())
+		diagnostic.AttributePath = cty.GetAttrPath(attr.APIToken)
+	}
+
+	return diagnostic
+}
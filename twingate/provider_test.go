@@ -0,0 +1,62 @@
+package twingate
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/Twingate/terraform-provider-twingate/twingate/internal/attr"
+	"github.com/Twingate/terraform-provider-twingate/twingate/sdk/fake"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestConfigureProbe(t *testing.T) {
+	server := fake.NewServer()
+	defer server.Close()
+
+	cases := []struct {
+		config          map[string]interface{}
+		expectedSummary string
+	}{
+		{
+			config: map[string]interface{}{attr.APIToken: fake.DefaultAPIToken},
+		},
+		{
+			config:          map[string]interface{}{attr.APIToken: "wrong-token"},
+			expectedSummary: "Invalid Twingate API token",
+		},
+		{
+			config: map[string]interface{}{attr.APIToken: "wrong-token", attr.SkipProbe: true},
+		},
+		{
+			config:          map[string]interface{}{attr.APIToken: fake.DefaultAPIToken, attr.URL: "http://twingate.invalid"},
+			expectedSummary: "Unable to resolve the Twingate network",
+		},
+	}
+
+	for n, c := range cases {
+		t.Run(fmt.Sprintf("case_%d", n), func(t *testing.T) {
+			config := map[string]interface{}{
+				attr.Network:      fake.Network,
+				attr.URL:          server.URL,
+				attr.HTTPMaxRetry: 0,
+			}
+
+			for key, value := range c.config {
+				config[key] = value
+			}
+
+			diags := Provider("test").Configure(context.Background(), terraform.NewResourceConfigRaw(config))
+
+			if c.expectedSummary == "" {
+				assert.False(t, diags.HasError(), diags)
+
+				return
+			}
+
+			assert.True(t, diags.HasError())
+			assert.Equal(t, c.expectedSummary, diags[0].Summary)
+		})
+	}
+}
@@ -0,0 +1,188 @@
+// Package sdk - public Go client of the Twingate API, built on the client of the Terraform provider.
+//
+// The commands of the provider binary, e.g. `export` and `replicate`, work with the network through this package.
+// The provider itself stays on the internal client, since its resources rely on operations which aren't part
+// of the public API, e.g. locks of shared objects, authoritative access and adoption of existing objects.
+// Every operation of the sdk wraps the internal one, and the sdk/fake server tests both against the same API.
+//
+//	c, err := sdk.NewClient("acme", sdk.WithAPIToken(os.Getenv("TWINGATE_API_TOKEN")))
+//	if err != nil {
+//		...
+//	}
+//
+//	network, err := c.CreateRemoteNetwork(ctx, &sdk.RemoteNetwork{Name: "Office", Location: sdk.LocationOther})
+package sdk
+
+import (
+	"context"
+	"time"
+
+	"github.com/Twingate/terraform-provider-twingate/twingate/internal/client"
+)
+
+const (
+	DefaultURL          = "twingate.com"
+	DefaultHTTPTimeout  = 10 * time.Second
+	DefaultHTTPMaxRetry = 10
+	// DefaultHTTPMaxConcurrency - limit of parallel requests made while reading nested pages, e.g. users of every group.
+	DefaultHTTPMaxConcurrency = 4
+)
+
+// Client - typed CRUD operations for all entities of a Twingate network.
+type Client struct {
+	client *client.Client
+}
+
+type options struct {
+	url                string
+	apiToken           string
+	apiTokenFile       string
+	apiTokenCommand    string
+	httpTimeout        time.Duration
+	httpMaxRetry       int
+	httpMaxConcurrency int
+	version            string
+	transport          client.TransportConfig
+}
+
+// Option - configures the Client created by NewClient.
+type Option func(opts *options)
+
+// WithURL - the Twingate domain, `twingate.com` by default.
+func WithURL(url string) Option {
+	return func(opts *options) {
+		opts.url = url
+	}
+}
+
+// WithAPIToken - the API token used to authenticate all requests.
+func WithAPIToken(apiToken string) Option {
+	return func(opts *options) {
+		opts.apiToken = apiToken
+	}
+}
+
+// WithAPITokenFile - reads the API token from the file instead, the file is read again when the API rejects the token.
+// Takes precedence over WithAPIToken.
+func WithAPITokenFile(path string) Option {
+	return func(opts *options) {
+		opts.apiTokenFile = path
+	}
+}
+
+// WithAPITokenCommand - runs the command with the system shell and uses its output as the API token,
+// the command is run again when the API rejects the token. Takes precedence over WithAPITokenFile.
+func WithAPITokenCommand(command string) Option {
+	return func(opts *options) {
+		opts.apiTokenCommand = command
+	}
+}
+
+// WithHTTPTimeout - time limit of a single HTTP request.
+func WithHTTPTimeout(timeout time.Duration) Option {
+	return func(opts *options) {
+		opts.httpTimeout = timeout
+	}
+}
+
+// WithHTTPMaxRetry - retry limit of failed HTTP requests.
+func WithHTTPMaxRetry(maxRetry int) Option {
+	return func(opts *options) {
+		opts.httpMaxRetry = maxRetry
+	}
+}
+
+// WithHTTPMaxConcurrency - limit of parallel HTTP requests made while reading nested pages of many objects.
+func WithHTTPMaxConcurrency(maxConcurrency int) Option {
+	return func(opts *options) {
+		opts.httpMaxConcurrency = maxConcurrency
+	}
+}
+
+// WithProxyURL - proxy used for all requests, by default it's taken from the HTTP_PROXY and HTTPS_PROXY environment variables.
+func WithProxyURL(proxyURL string) Option {
+	return func(opts *options) {
+		opts.transport.ProxyURL = proxyURL
+	}
+}
+
+// WithCACertFile - PEM file of certificates trusted in addition to the system roots, e.g. the CA of a TLS intercepting proxy.
+func WithCACertFile(path string) Option {
+	return func(opts *options) {
+		opts.transport.CACertFile = path
+	}
+}
+
+// WithCACertPEM - same as WithCACertFile, but with the PEM encoded certificates.
+func WithCACertPEM(pem string) Option {
+	return func(opts *options) {
+		opts.transport.CACertPEM = pem
+	}
+}
+
+// WithInsecureSkipVerify - disables verification of the server certificate, only meant for debugging.
+func WithInsecureSkipVerify(skip bool) Option {
+	return func(opts *options) {
+		opts.transport.InsecureSkipVerify = skip
+	}
+}
+
+// WithClientCertFiles - PEM files of the certificate and key presented to servers requiring mutual TLS.
+func WithClientCertFiles(certFile, keyFile string) Option {
+	return func(opts *options) {
+		opts.transport.ClientCertFile = certFile
+		opts.transport.ClientKeyFile = keyFile
+	}
+}
+
+// WithClientCertPEM - same as WithClientCertFiles, but with the PEM encoded certificate and key.
+func WithClientCertPEM(certPEM, keyPEM string) Option {
+	return func(opts *options) {
+		opts.transport.ClientCertPEM = certPEM
+		opts.transport.ClientKeyPEM = keyPEM
+	}
+}
+
+// WithVersion - version of the calling tool, reported in the User-Agent header.
+func WithVersion(version string) Option {
+	return func(opts *options) {
+		opts.version = version
+	}
+}
+
+// NewClient - creates a client of the given network, e.g. `acme` for `acme.twingate.com`.
+func NewClient(network string, opts ...Option) (*Client, error) {
+	cfg := options{
+		url:                DefaultURL,
+		httpTimeout:        DefaultHTTPTimeout,
+		httpMaxRetry:       DefaultHTTPMaxRetry,
+		httpMaxConcurrency: DefaultHTTPMaxConcurrency,
+		version:            "sdk",
+	}
+
+	for _, opt := range opts {
+		opt(&cfg)
+	}
+
+	c, err := client.New(network, client.Config{
+		URL:                cfg.url,
+		APIToken:           cfg.apiToken,
+		APITokenFile:       cfg.apiTokenFile,
+		APITokenCommand:    cfg.apiTokenCommand,
+		HTTPTimeout:        cfg.httpTimeout,
+		HTTPMaxRetry:       cfg.httpMaxRetry,
+		HTTPMaxConcurrency: cfg.httpMaxConcurrency,
+		Version:            cfg.version,
+		Transport:          cfg.transport,
+	})
+	if err != nil {
+		return nil, err //nolint:wrapcheck
+	}
+
+	return &Client{client: c}, nil
+}
+
+// Probe - checks that the network is reachable and the API token is accepted.
+func (c *Client) Probe(ctx context.Context) error {
+	return wrapError(c.client.Probe(ctx))
+}
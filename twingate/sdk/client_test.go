@@ -0,0 +1,157 @@
+package sdk
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+
+	"net/http"
+
+	"github.com/jarcoal/httpmock"
+	"github.com/stretchr/testify/assert"
+)
+
+var errBadRequest = errors.New("bad request")
+
+func TestNewClient(t *testing.T) {
+	cases := []struct {
+		network             string
+		opts                []Option
+		expectedURL         string
+		expectedConcurrency int
+		err                 error
+	}{
+		{
+			network:             "acme",
+			expectedURL:         "https://acme.twingate.com/api/graphql/",
+			expectedConcurrency: DefaultHTTPMaxConcurrency,
+		},
+		{
+			network:             "acme",
+			opts:                []Option{WithURL("twindev.com"), WithAPIToken("token"), WithHTTPTimeout(time.Second), WithHTTPMaxRetry(1), WithHTTPMaxConcurrency(2), WithVersion("test")},
+			expectedURL:         "https://acme.twindev.com/api/graphql/",
+			expectedConcurrency: 2,
+		},
+		{
+			network:             "acme",
+			opts:                []Option{WithURL("http://127.0.0.1:8080/")},
+			expectedURL:         "http://127.0.0.1:8080/api/graphql/",
+			expectedConcurrency: DefaultHTTPMaxConcurrency,
+		},
+		{
+			network: "",
+			err:     ErrNetworkNotSet,
+		},
+		{
+			network: "acme",
+			opts:    []Option{WithProxyURL("ftp://proxy.internal")},
+			err:     ErrInvalidProxyURL,
+		},
+		{
+			network: "acme",
+			opts:    []Option{WithCACertPEM("not a certificate")},
+			err:     ErrInvalidCACert,
+		},
+		{
+			network: "acme",
+			opts:    []Option{WithClientCertPEM("certificate", "")},
+			err:     ErrClientCertKeyMismatch,
+		},
+		{
+			network:             "acme",
+			opts:                []Option{WithProxyURL("http://proxy.internal:3128"), WithInsecureSkipVerify(true)},
+			expectedURL:         "https://acme.twingate.com/api/graphql/",
+			expectedConcurrency: DefaultHTTPMaxConcurrency,
+		},
+	}
+
+	for n, c := range cases {
+		t.Run(fmt.Sprintf("case_%d", n), func(t *testing.T) {
+			client, err := NewClient(c.network, c.opts...)
+
+			if c.err != nil {
+				assert.ErrorIs(t, err, c.err)
+				assert.Nil(t, client)
+
+				return
+			}
+
+			assert.NoError(t, err)
+			assert.Equal(t, c.expectedURL, client.client.GraphqlServerURL)
+			assert.Equal(t, c.expectedConcurrency, client.client.MaxConcurrency)
+		})
+	}
+}
+
+func newHTTPMockClient(t *testing.T) *Client {
+	t.Helper()
+
+	client, err := NewClient("test", WithURL("twindev.com"), WithAPIToken("xxxx"), WithHTTPTimeout(time.Second), WithHTTPMaxRetry(2))
+	assert.NoError(t, err)
+
+	httpmock.ActivateNonDefault(client.client.HTTPClient)
+
+	return client
+}
+
+func TestClientRemoteNetworksIterator(t *testing.T) {
+	page1 := `{
+	  "data": {
+	    "remoteNetworks": {
+	      "pageInfo": {"endCursor": "cursor", "hasNextPage": true},
+	      "edges": [{"node": {"id": "network1", "name": "Office", "location": "OTHER"}}]
+	    }
+	  }
+	}`
+	page2 := `{
+	  "data": {
+	    "remoteNetworks": {
+	      "pageInfo": {"hasNextPage": false},
+	      "edges": [{"node": {"id": "network2", "name": "AWS", "location": "AWS"}}]
+	    }
+	  }
+	}`
+
+	client := newHTTPMockClient(t)
+	defer httpmock.DeactivateAndReset()
+
+	responses := []string{page1, page2}
+	httpmock.RegisterResponder("POST", client.client.GraphqlServerURL, func(req *http.Request) (*http.Response, error) {
+		response := responses[0]
+		responses = responses[1:]
+
+		return httpmock.NewStringResponse(200, response), nil
+	})
+
+	it := client.RemoteNetworks()
+
+	assert.True(t, it.Next(context.Background()))
+	assert.Equal(t, 1, httpmock.GetTotalCallCount())
+
+	networks, err := it.Collect(context.Background())
+
+	assert.NoError(t, err)
+	assert.Equal(t, []*RemoteNetwork{
+		{ID: "network2", Name: "AWS", Location: LocationAWS},
+	}, networks)
+	assert.Equal(t, 2, httpmock.GetTotalCallCount())
+}
+
+func TestClientRemoteNetworksIteratorError(t *testing.T) {
+	client := newHTTPMockClient(t)
+	defer httpmock.DeactivateAndReset()
+
+	httpmock.RegisterResponder("POST", client.client.GraphqlServerURL, httpmock.NewErrorResponder(errBadRequest))
+
+	it := client.RemoteNetworks()
+
+	assert.False(t, it.Next(context.Background()))
+	assert.ErrorContains(t, it.Err(), "failed to read remote network with id All")
+
+	var apiErr *APIError
+	assert.ErrorAs(t, it.Err(), &apiErr)
+	assert.Equal(t, "read", apiErr.Operation)
+	assert.Equal(t, "remote network", apiErr.Resource)
+}
@@ -0,0 +1,71 @@
+package sdk
+
+import (
+	"context"
+
+	"github.com/Twingate/terraform-provider-twingate/twingate/internal/model"
+)
+
+func newConnector(connector *model.Connector) *Connector {
+	if connector == nil {
+		return nil
+	}
+
+	return &Connector{
+		ID:                   connector.ID,
+		Name:                 connector.Name,
+		RemoteNetworkID:      connector.NetworkID,
+		StatusUpdatesEnabled: connector.StatusUpdatesEnabled,
+	}
+}
+
+func (c *Connector) toModel() *model.Connector {
+	if c == nil {
+		return nil
+	}
+
+	return &model.Connector{
+		ID:                   c.ID,
+		Name:                 c.Name,
+		NetworkID:            c.RemoteNetworkID,
+		StatusUpdatesEnabled: c.StatusUpdatesEnabled,
+	}
+}
+
+// CreateConnector - creates a connector in the remote network, the name is generated when empty.
+func (c *Client) CreateConnector(ctx context.Context, connector *Connector) (*Connector, error) {
+	created, err := c.client.CreateConnector(ctx, connector.toModel())
+
+	return newConnector(created), wrapError(err)
+}
+
+func (c *Client) ReadConnector(ctx context.Context, connectorID string) (*Connector, error) {
+	connector, err := c.client.ReadConnector(ctx, connectorID)
+
+	return newConnector(connector), wrapError(err)
+}
+
+func (c *Client) UpdateConnector(ctx context.Context, connector *Connector) (*Connector, error) {
+	updated, err := c.client.UpdateConnector(ctx, connector.toModel())
+
+	return newConnector(updated), wrapError(err)
+}
+
+func (c *Client) DeleteConnector(ctx context.Context, connectorID string) error {
+	return wrapError(c.client.DeleteConnector(ctx, connectorID))
+}
+
+// GenerateConnectorTokens - generates new tokens of the connector, the previous tokens stop working.
+func (c *Client) GenerateConnectorTokens(ctx context.Context, connectorID string) (*ConnectorTokens, error) {
+	tokens, err := c.client.GenerateConnectorTokens(ctx, connectorID)
+	if err != nil {
+		return nil, wrapError(err)
+	}
+
+	return &ConnectorTokens{AccessToken: tokens.AccessToken, RefreshToken: tokens.RefreshToken}, nil
+}
+
+// Connectors - iterates over all connectors.
+func (c *Client) Connectors() *Iterator[*Connector] {
+	return newIterator(mapPages(c.client.ConnectorPages(), newConnector))
+}
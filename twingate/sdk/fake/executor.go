@@ -0,0 +1,193 @@
+package fake
+
+import (
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"strconv"
+)
+
+var ErrUnknownField = errors.New("unknown field")
+
+// object - GraphQL object, field values are either plain values or resolvers of the field arguments.
+type object map[string]interface{}
+
+type resolver func(args map[string]interface{}) (interface{}, error)
+
+// execute - projects the value on the selections, calling resolvers of the selected fields.
+func execute(selections []*field, value interface{}, variables map[string]interface{}) (interface{}, error) {
+	switch typed := value.(type) {
+	case object:
+		result := make(map[string]interface{}, len(selections))
+
+		for _, f := range selections {
+			fieldValue, ok := typed[f.name]
+			if !ok {
+				return nil, fmt.Errorf("%w: %s", ErrUnknownField, f.name)
+			}
+
+			if resolve, ok := fieldValue.(resolver); ok {
+				var err error
+
+				args, _ := substitute(f.args, variables).(map[string]interface{})
+
+				if fieldValue, err = resolve(args); err != nil {
+					return nil, err
+				}
+			}
+
+			projected, err := execute(f.selections, fieldValue, variables)
+			if err != nil {
+				return nil, err
+			}
+
+			result[f.alias] = projected
+		}
+
+		return result, nil
+	case []object:
+		result := make([]interface{}, 0, len(typed))
+
+		for _, item := range typed {
+			projected, err := execute(selections, item, variables)
+			if err != nil {
+				return nil, err
+			}
+
+			result = append(result, projected)
+		}
+
+		return result, nil
+	default:
+		return value, nil
+	}
+}
+
+// substitute - replaces variable references with their values.
+func substitute(value interface{}, variables map[string]interface{}) interface{} {
+	switch typed := value.(type) {
+	case nil:
+		return map[string]interface{}{}
+	case variable:
+		return variables[string(typed)]
+	case enum:
+		return string(typed)
+	case map[string]interface{}:
+		result := make(map[string]interface{}, len(typed))
+		for key, item := range typed {
+			result[key] = substituteValue(item, variables)
+		}
+
+		return result
+	case []interface{}:
+		result := make([]interface{}, 0, len(typed))
+		for _, item := range typed {
+			result = append(result, substituteValue(item, variables))
+		}
+
+		return result
+	default:
+		return value
+	}
+}
+
+func substituteValue(value interface{}, variables map[string]interface{}) interface{} {
+	if value == nil {
+		return nil
+	}
+
+	return substitute(value, variables)
+}
+
+// connection - page of the items, as selected by `first` and `after` arguments.
+func connection(items []object, args map[string]interface{}) object {
+	start := 0
+	if after := stringArg(args, "after"); after != "" {
+		start = decodeCursor(after) + 1
+	}
+
+	if start > len(items) {
+		start = len(items)
+	}
+
+	end := len(items)
+	if first, ok := args["first"].(float64); ok && int(first) > 0 && start+int(first) < end {
+		end = start + int(first)
+	}
+
+	edges := make([]object, 0, end-start)
+	for _, item := range items[start:end] {
+		edges = append(edges, object{"node": item})
+	}
+
+	endCursor := ""
+	if end > start {
+		endCursor = encodeCursor(end - 1)
+	}
+
+	return object{
+		"pageInfo": object{
+			"endCursor":   endCursor,
+			"hasNextPage": end < len(items),
+		},
+		"edges": edges,
+	}
+}
+
+func connectionResolver(items func() []object) resolver {
+	return func(args map[string]interface{}) (interface{}, error) {
+		return connection(items(), args), nil
+	}
+}
+
+func encodeCursor(index int) string {
+	return base64.StdEncoding.EncodeToString([]byte("cursor:" + strconv.Itoa(index)))
+}
+
+func decodeCursor(cursor string) int {
+	decoded, err := base64.StdEncoding.DecodeString(cursor)
+	if err != nil || len(decoded) < len("cursor:") {
+		return -1
+	}
+
+	index, err := strconv.Atoi(string(decoded[len("cursor:"):]))
+	if err != nil {
+		return -1
+	}
+
+	return index
+}
+
+func stringArg(args map[string]interface{}, name string) string {
+	value, _ := args[name].(string)
+
+	return value
+}
+
+func boolArg(args map[string]interface{}, name string) (bool, bool) {
+	value, ok := args[name].(bool)
+
+	return value, ok
+}
+
+func stringListArg(args map[string]interface{}, name string) []string {
+	values, _ := args[name].([]interface{})
+
+	result := make([]string, 0, len(values))
+	for _, value := range values {
+		if str, ok := value.(string); ok {
+			result = append(result, str)
+		}
+	}
+
+	return result
+}
+
+// filterEq - value of `filter: {<name>: {eq: ...}}` argument.
+func filterEq(args map[string]interface{}, name string) (string, bool) {
+	filter, _ := args["filter"].(map[string]interface{})
+	operator, _ := filter[name].(map[string]interface{})
+	value, ok := operator["eq"].(string)
+
+	return value, ok
+}
@@ -0,0 +1,487 @@
+package fake
+
+import (
+	"fmt"
+
+	"github.com/Twingate/terraform-provider-twingate/twingate/internal/model"
+	"github.com/Twingate/terraform-provider-twingate/twingate/internal/utils"
+)
+
+// mutationRoot - root mutation fields used by the client.
+//
+//nolint:funlen,maintidx
+func (s *store) mutationRoot() object {
+	return object{
+		"remoteNetworkCreate": mutation(func(args map[string]interface{}) object {
+			name := stringArg(args, "name")
+			if name == "" {
+				return failure("name is required")
+			}
+
+			item := &remoteNetwork{id: s.newID("RemoteNetwork"), name: name, location: stringArg(args, "location")}
+			if item.location == "" {
+				item.location = defaultLocation
+			}
+
+			s.remoteNetworks = append(s.remoteNetworks, item)
+
+			return success(s.remoteNetworkObject(item))
+		}),
+		"remoteNetworkUpdate": mutation(func(args map[string]interface{}) object {
+			item := findByID(s.remoteNetworks, stringArg(args, "id"), remoteNetworkID)
+			if item == nil {
+				return notFound("remote network", args)
+			}
+
+			if name := stringArg(args, "name"); name != "" {
+				item.name = name
+			}
+
+			if location := stringArg(args, "location"); location != "" {
+				item.location = location
+			}
+
+			return success(s.remoteNetworkObject(item))
+		}),
+		"remoteNetworkDelete": mutation(func(args map[string]interface{}) object {
+			id := stringArg(args, "id")
+
+			var ok bool
+			if s.remoteNetworks, ok = removeByID(s.remoteNetworks, id, remoteNetworkID); !ok {
+				return notFound("remote network", args)
+			}
+
+			// connectors and resources are deleted together with their remote network
+			s.connectors = utils.Filter(s.connectors, func(item *connector) bool { return item.remoteNetworkID != id })
+
+			for _, item := range s.resources {
+				if item.remoteNetworkID == id {
+					s.deleteResource(item.id)
+				}
+			}
+
+			return success(nil)
+		}),
+		"connectorCreate": mutation(func(args map[string]interface{}) object {
+			remoteNetwork := findByID(s.remoteNetworks, stringArg(args, "remoteNetworkId"), remoteNetworkID)
+			if remoteNetwork == nil {
+				return failure(fmt.Sprintf("remote network with id %s not found", stringArg(args, "remoteNetworkId")))
+			}
+
+			item := &connector{id: s.newID("Connector"), name: stringArg(args, "name"), remoteNetworkID: remoteNetwork.id}
+			if item.name == "" {
+				item.name = fmt.Sprintf("connector-%d", s.lastID)
+			}
+
+			item.statusUpdatesEnabled, _ = boolArg(args, "hasStatusNotificationsEnabled")
+			s.connectors = append(s.connectors, item)
+
+			return success(s.connectorObject(item))
+		}),
+		"connectorUpdate": mutation(func(args map[string]interface{}) object {
+			item := findByID(s.connectors, stringArg(args, "id"), connectorID)
+			if item == nil {
+				return notFound("connector", args)
+			}
+
+			if name := stringArg(args, "name"); name != "" {
+				item.name = name
+			}
+
+			if enabled, ok := boolArg(args, "hasStatusNotificationsEnabled"); ok {
+				item.statusUpdatesEnabled = enabled
+			}
+
+			return success(s.connectorObject(item))
+		}),
+		"connectorDelete": mutation(func(args map[string]interface{}) object {
+			var ok bool
+			if s.connectors, ok = removeByID(s.connectors, stringArg(args, "id"), connectorID); !ok {
+				return notFound("connector", args)
+			}
+
+			return success(nil)
+		}),
+		"connectorGenerateTokens": mutation(func(args map[string]interface{}) object {
+			item := findByID(s.connectors, stringArg(args, "connectorId"), connectorID)
+			if item == nil {
+				return failure(fmt.Sprintf("connector with id %s not found", stringArg(args, "connectorId")))
+			}
+
+			item.accessToken = s.newToken("access")
+			item.refreshToken = s.newToken("refresh")
+
+			result := success(nil)
+			result["connectorTokens"] = object{"accessToken": item.accessToken, "refreshToken": item.refreshToken}
+
+			return result
+		}),
+		"groupCreate": mutation(func(args map[string]interface{}) object {
+			name := stringArg(args, "name")
+			if name == "" {
+				return failure("name is required")
+			}
+
+			item := &group{
+				id:               s.newID("Group"),
+				name:             name,
+				groupType:        model.GroupTypeManual,
+				isActive:         true,
+				userIDs:          addStrings(nil, stringListArg(args, "userIds")),
+				securityPolicyID: stringArg(args, "securityPolicyId"),
+			}
+
+			if item.securityPolicyID == "" {
+				item.securityPolicyID = s.defaultSecurityPolicyID()
+			}
+
+			s.groups = append(s.groups, item)
+
+			return success(s.groupObject(item))
+		}),
+		"groupUpdate": mutation(func(args map[string]interface{}) object {
+			item := findByID(s.groups, stringArg(args, "id"), groupID)
+			if item == nil {
+				return notFound("group", args)
+			}
+
+			if item.groupType != model.GroupTypeManual {
+				return failure(fmt.Sprintf("%s group can't be updated", item.groupType))
+			}
+
+			if name := stringArg(args, "name"); name != "" {
+				item.name = name
+			}
+
+			if policyID := stringArg(args, "securityPolicyId"); policyID != "" {
+				item.securityPolicyID = policyID
+			}
+
+			item.userIDs = addStrings(item.userIDs, stringListArg(args, "addedUserIds"))
+			item.userIDs = removeStrings(item.userIDs, stringListArg(args, "removedUserIds"))
+
+			return success(s.groupObject(item))
+		}),
+		"groupDelete": mutation(func(args map[string]interface{}) object {
+			id := stringArg(args, "id")
+
+			var ok bool
+			if s.groups, ok = removeByID(s.groups, id, groupID); !ok {
+				return notFound("group", args)
+			}
+
+			for _, item := range s.resources {
+				item.groupIDs = removeStrings(item.groupIDs, []string{id})
+			}
+
+			return success(nil)
+		}),
+		"resourceCreate": mutation(func(args map[string]interface{}) object {
+			name, address := stringArg(args, "name"), stringArg(args, "address")
+			if name == "" || address == "" {
+				return failure("name and address are required")
+			}
+
+			remoteNetwork := findByID(s.remoteNetworks, stringArg(args, "remoteNetworkId"), remoteNetworkID)
+			if remoteNetwork == nil {
+				return failure(fmt.Sprintf("remote network with id %s not found", stringArg(args, "remoteNetworkId")))
+			}
+
+			item := &resource{
+				id:                       s.newID("Resource"),
+				name:                     name,
+				address:                  address,
+				remoteNetworkID:          remoteNetwork.id,
+				protocols:                parseProtocols(args["protocols"]),
+				groupIDs:                 addStrings(nil, stringListArg(args, "groupIds")),
+				isActive:                 true,
+				isVisible:                true,
+				isBrowserShortcutEnabled: true,
+				alias:                    stringArg(args, "alias"),
+			}
+
+			if isVisible, ok := boolArg(args, "isVisible"); ok {
+				item.isVisible = isVisible
+			}
+
+			if isBrowserShortcutEnabled, ok := boolArg(args, "isBrowserShortcutEnabled"); ok {
+				item.isBrowserShortcutEnabled = isBrowserShortcutEnabled
+			}
+
+			s.resources = append(s.resources, item)
+
+			return success(s.resourceObject(item))
+		}),
+		"resourceUpdate": mutation(func(args map[string]interface{}) object {
+			item := findByID(s.resources, stringArg(args, "id"), resourceID)
+			if item == nil {
+				return notFound("resource", args)
+			}
+
+			if remoteNetworkID := stringArg(args, "remoteNetworkId"); remoteNetworkID != "" {
+				item.remoteNetworkID = remoteNetworkID
+			}
+
+			updateResource(item, args)
+
+			return success(s.resourceObject(item))
+		}),
+		"resourceDelete": mutation(func(args map[string]interface{}) object {
+			if !s.deleteResource(stringArg(args, "id")) {
+				return notFound("resource", args)
+			}
+
+			return success(nil)
+		}),
+		"userCreate": mutation(func(args map[string]interface{}) object {
+			email := stringArg(args, "email")
+			if email == "" {
+				return failure("email is required")
+			}
+
+			for _, existing := range s.users {
+				if existing.email == email {
+					return failure(fmt.Sprintf("user with email %s already exists", email))
+				}
+			}
+
+			item := &user{
+				id:        s.newID("User"),
+				firstName: stringArg(args, "firstName"),
+				lastName:  stringArg(args, "lastName"),
+				email:     email,
+				role:      stringArg(args, "role"),
+				userType:  model.UserTypeManual,
+				state:     model.UserStatePending,
+			}
+
+			if item.role == "" {
+				item.role = model.UserRoleMember
+			}
+
+			s.users = append(s.users, item)
+
+			return success(s.userObject(item))
+		}),
+		"userDetailsUpdate": mutation(func(args map[string]interface{}) object {
+			item := findByID(s.users, stringArg(args, "id"), userID)
+			if item == nil {
+				return notFound("user", args)
+			}
+
+			if firstName := stringArg(args, "firstName"); firstName != "" {
+				item.firstName = firstName
+			}
+
+			if lastName := stringArg(args, "lastName"); lastName != "" {
+				item.lastName = lastName
+			}
+
+			if state := stringArg(args, "state"); state != "" {
+				item.state = state
+			}
+
+			return success(s.userObject(item))
+		}),
+		"userRoleUpdate": mutation(func(args map[string]interface{}) object {
+			item := findByID(s.users, stringArg(args, "id"), userID)
+			if item == nil {
+				return notFound("user", args)
+			}
+
+			if role := stringArg(args, "role"); role != "" {
+				item.role = role
+			}
+
+			return success(s.userObject(item))
+		}),
+		"userDelete": mutation(func(args map[string]interface{}) object {
+			id := stringArg(args, "id")
+
+			var ok bool
+			if s.users, ok = removeByID(s.users, id, userID); !ok {
+				return notFound("user", args)
+			}
+
+			for _, item := range s.groups {
+				item.userIDs = removeStrings(item.userIDs, []string{id})
+			}
+
+			return success(nil)
+		}),
+		"serviceAccountCreate": mutation(func(args map[string]interface{}) object {
+			name := stringArg(args, "name")
+			if name == "" {
+				return failure("name is required")
+			}
+
+			item := &serviceAccount{id: s.newID("ServiceAccount"), name: name}
+			s.serviceAccounts = append(s.serviceAccounts, item)
+
+			return success(s.serviceAccountObject(item))
+		}),
+		"serviceAccountUpdate": mutation(func(args map[string]interface{}) object {
+			item := findByID(s.serviceAccounts, stringArg(args, "id"), serviceAccountID)
+			if item == nil {
+				return notFound("service account", args)
+			}
+
+			if name := stringArg(args, "name"); name != "" {
+				item.name = name
+			}
+
+			item.resourceIDs = addStrings(item.resourceIDs, stringListArg(args, "addedResourceIds"))
+			item.resourceIDs = removeStrings(item.resourceIDs, stringListArg(args, "removedResourceIds"))
+
+			return success(s.serviceAccountObject(item))
+		}),
+		"serviceAccountDelete": mutation(func(args map[string]interface{}) object {
+			id := stringArg(args, "id")
+
+			var ok bool
+			if s.serviceAccounts, ok = removeByID(s.serviceAccounts, id, serviceAccountID); !ok {
+				return notFound("service account", args)
+			}
+
+			s.serviceKeys = utils.Filter(s.serviceKeys, func(item *serviceKey) bool { return item.serviceAccountID != id })
+
+			return success(nil)
+		}),
+		"serviceAccountKeyCreate": mutation(func(args map[string]interface{}) object {
+			account := findByID(s.serviceAccounts, stringArg(args, "serviceAccountId"), serviceAccountID)
+			if account == nil {
+				return failure(fmt.Sprintf("service account with id %s not found", stringArg(args, "serviceAccountId")))
+			}
+
+			days, _ := args["expirationTime"].(float64)
+
+			item := &serviceKey{
+				id:               s.newID("ServiceAccountKey"),
+				name:             stringArg(args, "name"),
+				serviceAccountID: account.id,
+				expiresAt:        expiresAt(int(days)),
+				status:           model.StatusActive,
+			}
+
+			s.serviceKeys = append(s.serviceKeys, item)
+
+			result := success(s.serviceKeyObject(item))
+			result["token"] = s.newToken("service-key")
+
+			return result
+		}),
+		"serviceAccountKeyUpdate": mutation(func(args map[string]interface{}) object {
+			item := findByID(s.serviceKeys, stringArg(args, "id"), serviceKeyID)
+			if item == nil {
+				return notFound("service account key", args)
+			}
+
+			item.name = stringArg(args, "name")
+
+			return success(s.serviceKeyObject(item))
+		}),
+		"serviceAccountKeyRevoke": mutation(func(args map[string]interface{}) object {
+			item := findByID(s.serviceKeys, stringArg(args, "id"), serviceKeyID)
+			if item == nil {
+				return notFound("service account key", args)
+			}
+
+			item.status = model.StatusRevoked
+
+			return success(s.serviceKeyObject(item))
+		}),
+		"serviceAccountKeyDelete": mutation(func(args map[string]interface{}) object {
+			item := findByID(s.serviceKeys, stringArg(args, "id"), serviceKeyID)
+			if item == nil {
+				return notFound("service account key", args)
+			}
+
+			if item.status == model.StatusActive {
+				return failure("active service account key can't be deleted, revoke it first")
+			}
+
+			s.serviceKeys, _ = removeByID(s.serviceKeys, item.id, serviceKeyID)
+
+			return success(nil)
+		}),
+	}
+}
+
+// updateResource - applies the arguments of the `resourceUpdate` mutation, absent arguments leave fields unchanged.
+func updateResource(item *resource, args map[string]interface{}) {
+	if name := stringArg(args, "name"); name != "" {
+		item.name = name
+	}
+
+	if address := stringArg(args, "address"); address != "" {
+		item.address = address
+	}
+
+	if value, ok := args["protocols"]; ok && value != nil {
+		item.protocols = parseProtocols(value)
+	}
+
+	if isActive, ok := boolArg(args, "isActive"); ok {
+		item.isActive = isActive
+	}
+
+	if isVisible, ok := boolArg(args, "isVisible"); ok {
+		item.isVisible = isVisible
+	}
+
+	if isBrowserShortcutEnabled, ok := boolArg(args, "isBrowserShortcutEnabled"); ok {
+		item.isBrowserShortcutEnabled = isBrowserShortcutEnabled
+	}
+
+	// explicit null clears the alias
+	if _, ok := args["alias"]; ok {
+		item.alias = stringArg(args, "alias")
+	}
+
+	item.groupIDs = addStrings(item.groupIDs, stringListArg(args, "addedGroupIds"))
+	item.groupIDs = removeStrings(item.groupIDs, stringListArg(args, "removedGroupIds"))
+}
+
+func (s *store) deleteResource(id string) bool {
+	var ok bool
+	if s.resources, ok = removeByID(s.resources, id, resourceID); !ok {
+		return false
+	}
+
+	for _, item := range s.serviceAccounts {
+		item.resourceIDs = removeStrings(item.resourceIDs, []string{id})
+	}
+
+	return true
+}
+
+func mutation(apply func(args map[string]interface{}) object) resolver {
+	return func(args map[string]interface{}) (interface{}, error) {
+		return apply(args), nil
+	}
+}
+
+// success - payload of a successful mutation, every mutation payload has the same fields to keep the selections simple.
+func success(entity interface{}) object {
+	return object{
+		"entity":          entity,
+		"ok":              true,
+		"error":           nil,
+		"connectorTokens": nil,
+		"token":           nil,
+	}
+}
+
+func failure(message string) object {
+	return object{
+		"entity":          nil,
+		"ok":              false,
+		"error":           message,
+		"connectorTokens": nil,
+		"token":           nil,
+	}
+}
+
+func notFound(typeName string, args map[string]interface{}) object {
+	return failure(fmt.Sprintf("%s with id %s not found", typeName, stringArg(args, "id")))
+}
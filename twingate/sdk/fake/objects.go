@@ -0,0 +1,181 @@
+package fake
+
+// GraphQL objects of the stored entities, nested connections are resolved when selected.
+
+func (s *store) remoteNetworkObject(item *remoteNetwork) object {
+	return object{
+		"id":       item.id,
+		"name":     item.name,
+		"location": item.location,
+	}
+}
+
+func (s *store) connectorObject(item *connector) object {
+	return object{
+		"id":                            item.id,
+		"name":                          item.name,
+		"remoteNetwork":                 object{"id": item.remoteNetworkID},
+		"hasStatusNotificationsEnabled": item.statusUpdatesEnabled,
+	}
+}
+
+func (s *store) resourceObject(item *resource) object {
+	return object{
+		"id":                       item.id,
+		"name":                     item.name,
+		"address":                  object{"value": item.address},
+		"remoteNetwork":            object{"id": item.remoteNetworkID},
+		"protocols":                protocolsObject(item.protocols),
+		"isActive":                 item.isActive,
+		"isVisible":                item.isVisible,
+		"isBrowserShortcutEnabled": item.isBrowserShortcutEnabled,
+		"alias":                    item.alias,
+		"groups": connectionResolver(func() []object {
+			objects := make([]object, 0, len(item.groupIDs))
+
+			for _, id := range item.groupIDs {
+				if found := findByID(s.groups, id, groupID); found != nil {
+					objects = append(objects, s.groupObject(found))
+				}
+			}
+
+			return objects
+		}),
+	}
+}
+
+func protocolsObject(item protocols) object {
+	return object{
+		"allowIcmp": item.allowIcmp,
+		"tcp":       protocolObject(item.tcp),
+		"udp":       protocolObject(item.udp),
+	}
+}
+
+func protocolObject(item protocol) object {
+	ports := make([]object, 0, len(item.ports))
+	for _, port := range item.ports {
+		ports = append(ports, object{"start": port[0], "end": port[1]})
+	}
+
+	return object{
+		"policy": item.policy,
+		"ports":  ports,
+	}
+}
+
+func (s *store) groupObject(item *group) object {
+	policy := findByID(s.securityPolicies, item.securityPolicyID, securityPolicyID)
+	if policy == nil {
+		policy = &securityPolicy{}
+	}
+
+	return object{
+		"id":             item.id,
+		"name":           item.name,
+		"isActive":       item.isActive,
+		"type":           item.groupType,
+		"securityPolicy": s.securityPolicyObject(policy),
+		"users": connectionResolver(func() []object {
+			objects := make([]object, 0, len(item.userIDs))
+
+			for _, id := range item.userIDs {
+				if found := findByID(s.users, id, userID); found != nil {
+					objects = append(objects, s.userObject(found))
+				}
+			}
+
+			return objects
+		}),
+	}
+}
+
+func (s *store) userObject(item *user) object {
+	return object{
+		"id":        item.id,
+		"firstName": item.firstName,
+		"lastName":  item.lastName,
+		"email":     item.email,
+		"role":      item.role,
+		"type":      item.userType,
+		"state":     item.state,
+	}
+}
+
+func (s *store) serviceAccountObject(item *serviceAccount) object {
+	return object{
+		"id":   item.id,
+		"name": item.name,
+		"resources": connectionResolver(func() []object {
+			objects := make([]object, 0, len(item.resourceIDs))
+
+			for _, id := range item.resourceIDs {
+				if found := findByID(s.resources, id, resourceID); found != nil {
+					objects = append(objects, s.resourceObject(found))
+				}
+			}
+
+			return objects
+		}),
+		"keys": connectionResolver(func() []object {
+			objects := make([]object, 0)
+
+			for _, key := range s.serviceKeys {
+				if key.serviceAccountID == item.id {
+					objects = append(objects, s.serviceKeyObject(key))
+				}
+			}
+
+			return objects
+		}),
+	}
+}
+
+func (s *store) serviceKeyObject(item *serviceKey) object {
+	account := findByID(s.serviceAccounts, item.serviceAccountID, serviceAccountID)
+	if account == nil {
+		account = &serviceAccount{id: item.serviceAccountID}
+	}
+
+	return object{
+		"id":             item.id,
+		"name":           item.name,
+		"expiresAt":      item.expiresAt,
+		"status":         item.status,
+		"serviceAccount": object{"id": account.id, "name": account.name},
+	}
+}
+
+func (s *store) securityPolicyObject(item *securityPolicy) object {
+	return object{
+		"id":   item.id,
+		"name": item.name,
+	}
+}
+
+func remoteNetworkID(item *remoteNetwork) string   { return item.id }
+func connectorID(item *connector) string           { return item.id }
+func resourceID(item *resource) string             { return item.id }
+func groupID(item *group) string                   { return item.id }
+func userID(item *user) string                     { return item.id }
+func serviceAccountID(item *serviceAccount) string { return item.id }
+func serviceKeyID(item *serviceKey) string         { return item.id }
+func securityPolicyID(item *securityPolicy) string { return item.id }
+
+func objects[T any](items []*T, toObject func(item *T) object) []object {
+	result := make([]object, 0, len(items))
+	for _, item := range items {
+		result = append(result, toObject(item))
+	}
+
+	return result
+}
+
+// nullable - nil object is returned as untyped nil, to be rendered as GraphQL null.
+func nullable[T any](item *T, toObject func(item *T) object) interface{} {
+	if item == nil {
+		return nil
+	}
+
+	return toObject(item)
+}
@@ -0,0 +1,372 @@
+package fake
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"unicode"
+)
+
+var ErrSyntax = errors.New("syntax error")
+
+// operation - parsed GraphQL document with a single operation, fragments and directives are not supported.
+type operation struct {
+	kind       string
+	selections []*field
+}
+
+type field struct {
+	alias      string
+	name       string
+	args       map[string]interface{}
+	selections []*field
+}
+
+// variable - reference to an operation variable in argument values.
+type variable string
+
+// enum - enum literal in argument values.
+type enum string
+
+type parser struct {
+	input string
+	pos   int
+}
+
+func parseOperation(input string) (*operation, error) {
+	p := &parser{input: input}
+	op := &operation{kind: "query"}
+
+	p.skipIgnored()
+
+	if p.peek() != '{' {
+		op.kind = p.name()
+
+		p.skipIgnored()
+
+		// optional operation name
+		if isNameStart(p.peek()) {
+			p.name()
+			p.skipIgnored()
+		}
+
+		// variable definitions are ignored, values are taken from the variables as they are
+		if p.peek() == '(' {
+			if err := p.skipBalanced('(', ')'); err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	selections, err := p.selectionSet()
+	if err != nil {
+		return nil, err
+	}
+
+	op.selections = selections
+
+	return op, nil
+}
+
+func (p *parser) selectionSet() ([]*field, error) {
+	if err := p.expect('{'); err != nil {
+		return nil, err
+	}
+
+	var fields []*field
+
+	for {
+		p.skipIgnored()
+
+		if p.peek() == '}' {
+			p.pos++
+
+			return fields, nil
+		}
+
+		f, err := p.field()
+		if err != nil {
+			return nil, err
+		}
+
+		fields = append(fields, f)
+	}
+}
+
+func (p *parser) field() (*field, error) {
+	name := p.name()
+	if name == "" {
+		return nil, p.errorf("expected field name")
+	}
+
+	f := &field{name: name, alias: name}
+
+	p.skipIgnored()
+
+	if p.peek() == ':' {
+		p.pos++
+		p.skipIgnored()
+
+		f.name = p.name()
+		p.skipIgnored()
+	}
+
+	if p.peek() == '(' {
+		args, err := p.arguments()
+		if err != nil {
+			return nil, err
+		}
+
+		f.args = args
+
+		p.skipIgnored()
+	}
+
+	if p.peek() == '{' {
+		selections, err := p.selectionSet()
+		if err != nil {
+			return nil, err
+		}
+
+		f.selections = selections
+	}
+
+	return f, nil
+}
+
+func (p *parser) arguments() (map[string]interface{}, error) {
+	args := make(map[string]interface{})
+
+	if err := p.expect('('); err != nil {
+		return nil, err
+	}
+
+	for {
+		p.skipIgnored()
+
+		if p.peek() == ')' {
+			p.pos++
+
+			return args, nil
+		}
+
+		name := p.name()
+		p.skipIgnored()
+
+		if err := p.expect(':'); err != nil {
+			return nil, err
+		}
+
+		value, err := p.value()
+		if err != nil {
+			return nil, err
+		}
+
+		args[name] = value
+	}
+}
+
+func (p *parser) value() (interface{}, error) {
+	p.skipIgnored()
+
+	char := p.peek()
+
+	switch {
+	case char == '$':
+		p.pos++
+
+		return variable(p.name()), nil
+	case char == '"':
+		return p.stringValue()
+	case char == '[':
+		return p.listValue()
+	case char == '{':
+		return p.objectValue()
+	case char == '-' || unicode.IsDigit(rune(char)):
+		return p.numberValue()
+	case isNameStart(char):
+		switch name := p.name(); name {
+		case "true":
+			return true, nil
+		case "false":
+			return false, nil
+		case "null":
+			return nil, nil
+		default:
+			return enum(name), nil
+		}
+	}
+
+	return nil, p.errorf("unexpected character %q", char)
+}
+
+func (p *parser) stringValue() (interface{}, error) {
+	start := p.pos
+	p.pos++
+
+	for p.pos < len(p.input) && p.input[p.pos] != '"' {
+		if p.input[p.pos] == '\\' {
+			p.pos++
+		}
+
+		p.pos++
+	}
+
+	if p.pos >= len(p.input) {
+		return nil, p.errorf("unterminated string")
+	}
+
+	p.pos++
+
+	value, err := strconv.Unquote(p.input[start:p.pos])
+	if err != nil {
+		return nil, p.errorf("invalid string: %v", err)
+	}
+
+	return value, nil
+}
+
+func (p *parser) numberValue() (interface{}, error) {
+	start := p.pos
+	p.pos++
+
+	for p.pos < len(p.input) && strings.ContainsRune("0123456789.eE+-", rune(p.input[p.pos])) {
+		p.pos++
+	}
+
+	value, err := strconv.ParseFloat(p.input[start:p.pos], 64)
+	if err != nil {
+		return nil, p.errorf("invalid number: %v", err)
+	}
+
+	return value, nil
+}
+
+func (p *parser) listValue() (interface{}, error) {
+	p.pos++
+
+	list := make([]interface{}, 0)
+
+	for {
+		p.skipIgnored()
+
+		if p.peek() == ']' {
+			p.pos++
+
+			return list, nil
+		}
+
+		value, err := p.value()
+		if err != nil {
+			return nil, err
+		}
+
+		list = append(list, value)
+	}
+}
+
+func (p *parser) objectValue() (interface{}, error) {
+	p.pos++
+
+	object := make(map[string]interface{})
+
+	for {
+		p.skipIgnored()
+
+		if p.peek() == '}' {
+			p.pos++
+
+			return object, nil
+		}
+
+		name := p.name()
+		p.skipIgnored()
+
+		if err := p.expect(':'); err != nil {
+			return nil, err
+		}
+
+		value, err := p.value()
+		if err != nil {
+			return nil, err
+		}
+
+		object[name] = value
+	}
+}
+
+func (p *parser) name() string {
+	start := p.pos
+
+	for p.pos < len(p.input) && (isNameStart(p.input[p.pos]) || unicode.IsDigit(rune(p.input[p.pos]))) {
+		p.pos++
+	}
+
+	return p.input[start:p.pos]
+}
+
+func (p *parser) expect(char byte) error {
+	p.skipIgnored()
+
+	if p.peek() != char {
+		return p.errorf("expected %q", char)
+	}
+
+	p.pos++
+
+	return nil
+}
+
+func (p *parser) skipBalanced(open, closing byte) error {
+	depth := 0
+
+	for ; p.pos < len(p.input); p.pos++ {
+		switch p.input[p.pos] {
+		case open:
+			depth++
+		case closing:
+			depth--
+
+			if depth == 0 {
+				p.pos++
+
+				return nil
+			}
+		}
+	}
+
+	return p.errorf("expected %q", closing)
+}
+
+// skipIgnored - skips white space, commas and comments.
+func (p *parser) skipIgnored() {
+	for p.pos < len(p.input) {
+		switch char := p.input[p.pos]; {
+		case char == ',' || unicode.IsSpace(rune(char)):
+			p.pos++
+		case char == '#':
+			for p.pos < len(p.input) && p.input[p.pos] != '\n' {
+				p.pos++
+			}
+		default:
+			return
+		}
+	}
+}
+
+func (p *parser) peek() byte {
+	if p.pos >= len(p.input) {
+		return 0
+	}
+
+	return p.input[p.pos]
+}
+
+func (p *parser) errorf(format string, args ...interface{}) error {
+	return fmt.Errorf("%w at %d: %s", ErrSyntax, p.pos, fmt.Sprintf(format, args...))
+}
+
+func isNameStart(char byte) bool {
+	return char == '_' || char >= 'a' && char <= 'z' || char >= 'A' && char <= 'Z'
+}
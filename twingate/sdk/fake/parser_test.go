@@ -0,0 +1,68 @@
+package fake
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseOperation(t *testing.T) {
+	cases := []struct {
+		input    string
+		expected *operation
+		err      error
+	}{
+		{
+			input: `{remoteNetwork(id: $id){id,name}}`,
+			expected: &operation{kind: "query", selections: []*field{
+				{alias: "remoteNetwork", name: "remoteNetwork", args: map[string]interface{}{"id": variable("id")}, selections: []*field{
+					{alias: "id", name: "id"},
+					{alias: "name", name: "name"},
+				}},
+			}},
+		},
+		{
+			input: `mutation deleteGroup($id:ID!){ removed: groupDelete(id: $id) { ok error } }`,
+			expected: &operation{kind: "mutation", selections: []*field{
+				{alias: "removed", name: "groupDelete", args: map[string]interface{}{"id": variable("id")}, selections: []*field{
+					{alias: "ok", name: "ok"},
+					{alias: "error", name: "error"},
+				}},
+			}},
+		},
+		{
+			input: `query {resources(filter: {name: {eq: "db"}}, first: 10, types: [MANUAL, SYNCED], active: true, alias: null){id}}`,
+			expected: &operation{kind: "query", selections: []*field{
+				{alias: "resources", name: "resources", args: map[string]interface{}{
+					"filter": map[string]interface{}{"name": map[string]interface{}{"eq": "db"}},
+					"first":  float64(10),
+					"types":  []interface{}{enum("MANUAL"), enum("SYNCED")},
+					"active": true,
+					"alias":  nil,
+				}, selections: []*field{
+					{alias: "id", name: "id"},
+				}},
+			}},
+		},
+		{
+			input: `{remoteNetwork(id: $id){id}`,
+			err:   ErrSyntax,
+		},
+	}
+
+	for n, c := range cases {
+		t.Run(fmt.Sprintf("case_%d", n), func(t *testing.T) {
+			op, err := parseOperation(c.input)
+
+			if c.err != nil {
+				assert.ErrorIs(t, err, c.err)
+
+				return
+			}
+
+			assert.NoError(t, err)
+			assert.Equal(t, c.expected, op)
+		})
+	}
+}
@@ -0,0 +1,114 @@
+package fake
+
+import (
+	"github.com/Twingate/terraform-provider-twingate/twingate/internal/utils"
+)
+
+// queryRoot - root query fields used by the client.
+func (s *store) queryRoot() object {
+	return object{
+		"remoteNetwork": byID(func(id string) interface{} {
+			return nullable(findByID(s.remoteNetworks, id, remoteNetworkID), s.remoteNetworkObject)
+		}),
+		"remoteNetworks": list(func(args map[string]interface{}) []object {
+			items := s.remoteNetworks
+			if name, ok := filterEq(args, "name"); ok {
+				items = utils.Filter(items, func(item *remoteNetwork) bool { return item.name == name })
+			}
+
+			return objects(items, s.remoteNetworkObject)
+		}),
+		"connector": byID(func(id string) interface{} {
+			return nullable(findByID(s.connectors, id, connectorID), s.connectorObject)
+		}),
+		"connectors": list(func(map[string]interface{}) []object {
+			return objects(s.connectors, s.connectorObject)
+		}),
+		"resource": byID(func(id string) interface{} {
+			return nullable(findByID(s.resources, id, resourceID), s.resourceObject)
+		}),
+		"resources": list(func(args map[string]interface{}) []object {
+			items := s.resources
+			if name, ok := filterEq(args, "name"); ok {
+				items = utils.Filter(items, func(item *resource) bool { return item.name == name })
+			}
+
+			return objects(items, s.resourceObject)
+		}),
+		"group": byID(func(id string) interface{} {
+			return nullable(findByID(s.groups, id, groupID), s.groupObject)
+		}),
+		"groups": list(func(args map[string]interface{}) []object {
+			return objects(utils.Filter(s.groups, groupFilter(args)), s.groupObject)
+		}),
+		"user": byID(func(id string) interface{} {
+			return nullable(findByID(s.users, id, userID), s.userObject)
+		}),
+		"users": list(func(map[string]interface{}) []object {
+			return objects(s.users, s.userObject)
+		}),
+		"serviceAccount": byID(func(id string) interface{} {
+			return nullable(findByID(s.serviceAccounts, id, serviceAccountID), s.serviceAccountObject)
+		}),
+		"serviceAccounts": list(func(args map[string]interface{}) []object {
+			items := s.serviceAccounts
+			if name, ok := filterEq(args, "name"); ok {
+				items = utils.Filter(items, func(item *serviceAccount) bool { return item.name == name })
+			}
+
+			return objects(items, s.serviceAccountObject)
+		}),
+		"serviceAccountKey": byID(func(id string) interface{} {
+			return nullable(findByID(s.serviceKeys, id, serviceKeyID), s.serviceKeyObject)
+		}),
+		"securityPolicy": resolver(func(args map[string]interface{}) (interface{}, error) {
+			id, name := stringArg(args, "id"), stringArg(args, "name")
+
+			for _, policy := range s.securityPolicies {
+				if (id != "" && policy.id == id) || (id == "" && name != "" && policy.name == name) {
+					return s.securityPolicyObject(policy), nil
+				}
+			}
+
+			return nil, nil //nolint:nilnil
+		}),
+		"securityPolicies": list(func(map[string]interface{}) []object {
+			return objects(s.securityPolicies, s.securityPolicyObject)
+		}),
+	}
+}
+
+func byID(find func(id string) interface{}) resolver {
+	return func(args map[string]interface{}) (interface{}, error) {
+		return find(stringArg(args, "id")), nil
+	}
+}
+
+func list(items func(args map[string]interface{}) []object) resolver {
+	return func(args map[string]interface{}) (interface{}, error) {
+		return connection(items(args), args), nil
+	}
+}
+
+// groupFilter - matches groups by `filter: {name: {eq}, type: {in}, isActive: {eq}}`, missing conditions match everything.
+func groupFilter(args map[string]interface{}) func(item *group) bool {
+	filter, _ := args["filter"].(map[string]interface{})
+
+	name, hasName := filterEq(args, "name")
+	typeFilter, _ := filter["type"].(map[string]interface{})
+	types, hasTypes := typeFilter["in"].([]interface{})
+	activeFilter, _ := filter["isActive"].(map[string]interface{})
+	isActive, hasIsActive := activeFilter["eq"].(bool)
+
+	return func(item *group) bool {
+		if hasName && item.name != name {
+			return false
+		}
+
+		if hasTypes && !utils.Contains(types, interface{}(item.groupType)) {
+			return false
+		}
+
+		return !hasIsActive || item.isActive == isActive
+	}
+}
@@ -0,0 +1,260 @@
+// Package fake provides an in-memory Twingate API server for tests which shouldn't depend on a real network.
+//
+// The server keeps its state between requests and implements the GraphQL queries and mutations used by the client,
+// including pagination and filters, so both sdk.Client and the provider can run against it:
+//
+//	server := fake.NewServer()
+//	defer server.Close()
+//
+//	client, err := server.NewClient()
+package fake
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+
+	"github.com/Twingate/terraform-provider-twingate/twingate/internal/model"
+	"github.com/Twingate/terraform-provider-twingate/twingate/sdk"
+)
+
+const (
+	// Network - network name to configure clients with, the server accepts any network name.
+	Network = "fake"
+
+	// DefaultAPIToken - API token accepted by the server unless configured with WithAPIToken.
+	DefaultAPIToken = "fake-api-token" //nolint:gosec
+
+	graphqlPath        = "/api/graphql/"
+	validateTokensPath = "/api/v4/connector/validate_tokens"
+
+	headerAPIKey = "X-API-KEY"
+)
+
+// Server - in-memory Twingate API, served over HTTP by the embedded httptest.Server.
+type Server struct {
+	*httptest.Server
+
+	apiToken string
+
+	mu    sync.Mutex
+	store *store
+}
+
+type Option func(server *Server)
+
+// WithAPIToken - API token expected in requests, an empty token disables the check.
+func WithAPIToken(apiToken string) Option {
+	return func(server *Server) {
+		server.apiToken = apiToken
+	}
+}
+
+// NewServer - starts a server with an empty network, having only the default security policy and the Everyone group.
+func NewServer(opts ...Option) *Server {
+	server := &Server{
+		apiToken: DefaultAPIToken,
+		store:    newStore(),
+	}
+
+	for _, opt := range opts {
+		opt(server)
+	}
+
+	mux := http.NewServeMux()
+	mux.HandleFunc(graphqlPath, server.serveGraphQL)
+	mux.HandleFunc(validateTokensPath, server.serveValidateTokens)
+
+	server.Server = httptest.NewServer(server.authorize(mux))
+
+	return server
+}
+
+// NewClient - SDK client connected to the server, options are applied after the server ones.
+func (s *Server) NewClient(opts ...sdk.Option) (*sdk.Client, error) {
+	return sdk.NewClient(Network, append([]sdk.Option{sdk.WithURL(s.URL), sdk.WithAPIToken(s.apiToken)}, opts...)...) //nolint:wrapcheck
+}
+
+// AddUser - adds a user, unlike the userCreate mutation it allows to add synced and active users.
+func (s *Server) AddUser(input *sdk.User) *sdk.User {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	item := &user{
+		id:        s.store.newID("User"),
+		firstName: input.FirstName,
+		lastName:  input.LastName,
+		email:     input.Email,
+		role:      valueOrDefault(input.Role, model.UserRoleMember),
+		userType:  valueOrDefault(input.Type, model.UserTypeManual),
+		state:     model.UserStateActive,
+	}
+
+	s.store.users = append(s.store.users, item)
+
+	return &sdk.User{
+		ID:        item.id,
+		FirstName: item.firstName,
+		LastName:  item.lastName,
+		Email:     item.email,
+		Role:      item.role,
+		Type:      item.userType,
+		IsActive:  true,
+	}
+}
+
+// AddGroup - adds a group, unlike the groupCreate mutation it allows to add synced and system groups.
+func (s *Server) AddGroup(input *sdk.Group) *sdk.Group {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	item := &group{
+		id:               s.store.newID("Group"),
+		name:             input.Name,
+		groupType:        valueOrDefault(input.Type, model.GroupTypeManual),
+		isActive:         input.IsActive,
+		userIDs:          addStrings(nil, input.Users),
+		securityPolicyID: valueOrDefault(input.SecurityPolicyID, s.store.defaultSecurityPolicyID()),
+	}
+
+	s.store.groups = append(s.store.groups, item)
+
+	return &sdk.Group{
+		ID:               item.id,
+		Name:             item.name,
+		Type:             item.groupType,
+		IsActive:         item.isActive,
+		Users:            item.userIDs,
+		SecurityPolicyID: item.securityPolicyID,
+	}
+}
+
+// AddSecurityPolicy - adds a security policy, these can't be created through the API.
+func (s *Server) AddSecurityPolicy(name string) *sdk.SecurityPolicy {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	item := &securityPolicy{id: s.store.newID("SecurityPolicy"), name: name}
+	s.store.securityPolicies = append(s.store.securityPolicies, item)
+
+	return &sdk.SecurityPolicy{ID: item.id, Name: item.name}
+}
+
+// Reset - drops all objects created since the server was started.
+func (s *Server) Reset() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.store = newStore()
+}
+
+func (s *Server) authorize(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(writer http.ResponseWriter, req *http.Request) {
+		if req.Method != http.MethodPost {
+			http.Error(writer, "method not allowed", http.StatusMethodNotAllowed)
+
+			return
+		}
+
+		if s.apiToken != "" && req.Header.Get(headerAPIKey) != s.apiToken {
+			http.Error(writer, `{"error":"invalid API token"}`, http.StatusUnauthorized)
+
+			return
+		}
+
+		next.ServeHTTP(writer, req)
+	})
+}
+
+type graphqlRequest struct {
+	Query     string                 `json:"query"`
+	Variables map[string]interface{} `json:"variables"`
+}
+
+type graphqlError struct {
+	Message string `json:"message"`
+}
+
+type graphqlResponse struct {
+	Data   interface{}    `json:"data"`
+	Errors []graphqlError `json:"errors,omitempty"`
+}
+
+func (s *Server) serveGraphQL(writer http.ResponseWriter, req *http.Request) {
+	var request graphqlRequest
+	if err := json.NewDecoder(req.Body).Decode(&request); err != nil {
+		http.Error(writer, fmt.Sprintf("invalid request: %v", err), http.StatusBadRequest)
+
+		return
+	}
+
+	data, err := s.execute(request)
+
+	response := graphqlResponse{Data: data}
+	if err != nil {
+		response = graphqlResponse{Errors: []graphqlError{{Message: err.Error()}}}
+	}
+
+	writer.Header().Set("Content-Type", "application/json")
+	_ = json.NewEncoder(writer).Encode(response)
+}
+
+func (s *Server) execute(request graphqlRequest) (interface{}, error) {
+	op, err := parseOperation(request.Query)
+	if err != nil {
+		return nil, err
+	}
+
+	// operations run one at a time, so a mutation is never observed half applied
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	var root object
+
+	switch op.kind {
+	case "query":
+		root = s.store.queryRoot()
+	case "mutation":
+		root = s.store.mutationRoot()
+	default:
+		return nil, fmt.Errorf("%w: unsupported operation %s", ErrSyntax, op.kind)
+	}
+
+	return execute(op.selections, root, request.Variables)
+}
+
+// serveValidateTokens - accepts tokens generated by the connectorGenerateTokens mutation.
+func (s *Server) serveValidateTokens(writer http.ResponseWriter, req *http.Request) {
+	var payload struct {
+		RefreshToken string `json:"refresh_token"`
+	}
+
+	_ = json.NewDecoder(req.Body).Decode(&payload)
+
+	accessToken := strings.TrimPrefix(req.Header.Get("Authorization"), "Bearer ")
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	for _, item := range s.store.connectors {
+		if item.accessToken != "" && item.accessToken == accessToken && item.refreshToken == payload.RefreshToken {
+			writer.Header().Set("Content-Type", "application/json")
+			_, _ = writer.Write([]byte("{}"))
+
+			return
+		}
+	}
+
+	http.Error(writer, `{"error":"invalid tokens"}`, http.StatusUnauthorized)
+}
+
+func valueOrDefault(value, defaultValue string) string {
+	if value == "" {
+		return defaultValue
+	}
+
+	return value
+}
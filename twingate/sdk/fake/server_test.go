@@ -0,0 +1,271 @@
+package fake
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/Twingate/terraform-provider-twingate/twingate/internal/client"
+	"github.com/Twingate/terraform-provider-twingate/twingate/internal/model"
+	"github.com/Twingate/terraform-provider-twingate/twingate/sdk"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func newTestClient(t *testing.T, server *Server) *sdk.Client {
+	t.Helper()
+
+	c, err := server.NewClient(sdk.WithHTTPTimeout(time.Second), sdk.WithHTTPMaxRetry(0))
+	require.NoError(t, err)
+
+	return c
+}
+
+// newInternalClient - client of the provider, for operations which the sdk doesn't expose.
+func newInternalClient(t *testing.T, server *Server) *client.Client {
+	t.Helper()
+
+	c, err := client.New(Network, client.Config{URL: server.URL, APIToken: server.apiToken, HTTPTimeout: time.Second, Version: "test"})
+	require.NoError(t, err)
+
+	return c
+}
+
+func TestRemoteNetworkLifecycle(t *testing.T) {
+	server := NewServer()
+	defer server.Close()
+
+	c := newTestClient(t, server)
+	ctx := context.Background()
+
+	created, err := c.CreateRemoteNetwork(ctx, &sdk.RemoteNetwork{Name: "office", Location: sdk.LocationAWS})
+	require.NoError(t, err)
+	assert.NotEmpty(t, created.ID)
+	assert.Equal(t, sdk.LocationAWS, created.Location)
+
+	updated, err := c.UpdateRemoteNetwork(ctx, &sdk.RemoteNetwork{ID: created.ID, Name: "head office", Location: sdk.LocationAWS})
+	require.NoError(t, err)
+	assert.Equal(t, "head office", updated.Name)
+
+	read, err := c.ReadRemoteNetworkByName(ctx, "head office")
+	require.NoError(t, err)
+	assert.Equal(t, created.ID, read.ID)
+
+	require.NoError(t, c.DeleteRemoteNetwork(ctx, created.ID))
+
+	_, err = c.ReadRemoteNetwork(ctx, created.ID)
+	assert.ErrorIs(t, err, sdk.ErrNotFound)
+
+	err = c.DeleteRemoteNetwork(ctx, created.ID)
+	assert.ErrorContains(t, err, "not found")
+}
+
+func TestPagination(t *testing.T) {
+	t.Setenv(client.EnvPageLimit, "2")
+
+	server := NewServer()
+	defer server.Close()
+
+	c := newTestClient(t, server)
+	ctx := context.Background()
+
+	userIDs := make([]string, 0, 5)
+
+	for _, email := range []string{"a@acme.com", "b@acme.com", "c@acme.com", "d@acme.com", "e@acme.com"} {
+		user, err := c.CreateUser(ctx, &sdk.User{Email: email})
+		require.NoError(t, err)
+
+		userIDs = append(userIDs, user.ID)
+	}
+
+	users, err := c.Users().Collect(ctx)
+	require.NoError(t, err)
+	assert.Len(t, users, 5)
+
+	group, err := c.CreateGroup(ctx, &sdk.Group{Name: "engineering", Users: userIDs})
+	require.NoError(t, err)
+
+	read, err := c.ReadGroup(ctx, group.ID)
+	require.NoError(t, err)
+	assert.Equal(t, userIDs, read.Users)
+}
+
+func TestConcurrentNestedPagination(t *testing.T) {
+	t.Setenv(client.EnvPageLimit, "2")
+
+	server := NewServer()
+	defer server.Close()
+
+	c := newInternalClient(t, server)
+	ctx := context.Background()
+
+	userIDs := make([]string, 0, 5)
+
+	for _, email := range []string{"a@acme.com", "b@acme.com", "c@acme.com", "d@acme.com", "e@acme.com"} {
+		userIDs = append(userIDs, server.AddUser(&sdk.User{Email: email}).ID)
+	}
+
+	expected := make([]string, 0, 10)
+
+	for i := 0; i < 10; i++ {
+		group := server.AddGroup(&sdk.Group{Name: fmt.Sprintf("group-%d", i), IsActive: true, Users: userIDs[:i%len(userIDs)+1]})
+		expected = append(expected, group.ID)
+	}
+
+	groupType := model.GroupTypeManual
+
+	groups, err := c.ReadFullGroups(ctx, &model.GroupsFilter{Type: &groupType})
+	require.NoError(t, err)
+	require.Len(t, groups, len(expected))
+
+	for i, group := range groups {
+		assert.Equal(t, expected[i], group.ID)
+		assert.Equal(t, userIDs[:i%len(userIDs)+1], group.Users)
+	}
+}
+
+func TestFilters(t *testing.T) {
+	server := NewServer()
+	defer server.Close()
+
+	synced := server.AddGroup(&sdk.Group{Name: "okta", Type: sdk.GroupTypeSynced, IsActive: true})
+
+	c := newTestClient(t, server)
+	ctx := context.Background()
+
+	_, err := c.CreateGroup(ctx, &sdk.Group{Name: "manual"})
+	require.NoError(t, err)
+
+	groupType := sdk.GroupTypeSynced
+
+	groups, err := c.Groups(&sdk.GroupsFilter{Type: &groupType}).Collect(ctx)
+	require.NoError(t, err)
+	require.Len(t, groups, 1)
+	assert.Equal(t, synced.ID, groups[0].ID)
+
+	name := "manual"
+
+	groups, err = c.Groups(&sdk.GroupsFilter{Name: &name}).Collect(ctx)
+	require.NoError(t, err)
+	require.Len(t, groups, 1)
+	assert.Equal(t, sdk.GroupTypeManual, groups[0].Type)
+
+	all, err := c.Groups(nil).Collect(ctx)
+	require.NoError(t, err)
+	assert.Len(t, all, 3)
+}
+
+func TestResourceLifecycle(t *testing.T) {
+	server := NewServer()
+	defer server.Close()
+
+	c := newTestClient(t, server)
+	ctx := context.Background()
+
+	remoteNetwork, err := c.CreateRemoteNetwork(ctx, &sdk.RemoteNetwork{Name: "office"})
+	require.NoError(t, err)
+
+	group, err := c.CreateGroup(ctx, &sdk.Group{Name: "engineering"})
+	require.NoError(t, err)
+
+	alias := "db.internal"
+	protocols := &sdk.Protocols{
+		AllowIcmp: false,
+		TCP:       &sdk.Protocol{Policy: sdk.PolicyRestricted, Ports: []sdk.PortRange{{Start: 5432, End: 5432}}},
+		UDP:       &sdk.Protocol{Policy: sdk.PolicyDenyAll},
+	}
+
+	created, err := c.CreateResource(ctx, &sdk.Resource{
+		Name:            "db",
+		Address:         "10.0.0.1",
+		RemoteNetworkID: remoteNetwork.ID,
+		Groups:          []string{group.ID},
+		Protocols:       protocols,
+		Alias:           &alias,
+	})
+	require.NoError(t, err)
+
+	read, err := c.ReadResource(ctx, created.ID)
+	require.NoError(t, err)
+	assert.Equal(t, "10.0.0.1", read.Address)
+	assert.Equal(t, []string{group.ID}, read.Groups)
+	assert.Equal(t, &alias, read.Alias)
+	assert.Equal(t, protocols, read.Protocols)
+
+	require.NoError(t, c.RemoveResourceGroups(ctx, created.ID, []string{group.ID}))
+
+	read, err = c.ReadResource(ctx, created.ID)
+	require.NoError(t, err)
+	assert.Empty(t, read.Groups)
+
+	require.NoError(t, c.DeleteRemoteNetwork(ctx, remoteNetwork.ID))
+
+	_, err = c.ReadResource(ctx, created.ID)
+	assert.ErrorIs(t, err, sdk.ErrNotFound)
+}
+
+func TestMutationErrors(t *testing.T) {
+	server := NewServer()
+	defer server.Close()
+
+	c := newTestClient(t, server)
+	ctx := context.Background()
+
+	_, err := c.CreateResource(ctx, &sdk.Resource{Name: "db", Address: "10.0.0.1", RemoteNetworkID: "unknown"})
+	assert.ErrorContains(t, err, "remote network with id unknown not found")
+
+	account, err := c.CreateServiceAccount(ctx, "ci")
+	require.NoError(t, err)
+
+	key, err := c.CreateServiceKey(ctx, &sdk.ServiceKey{ServiceAccountID: account.ID, Name: "key"})
+	require.NoError(t, err)
+	assert.NotEmpty(t, key.Token)
+
+	err = c.DeleteServiceKey(ctx, key.ID)
+	assert.ErrorContains(t, err, "revoke it first")
+
+	require.NoError(t, c.RevokeServiceKey(ctx, key.ID))
+	require.NoError(t, c.DeleteServiceKey(ctx, key.ID))
+}
+
+func TestConnectorTokens(t *testing.T) {
+	server := NewServer()
+	defer server.Close()
+
+	c := newTestClient(t, server)
+	ctx := context.Background()
+
+	remoteNetwork, err := c.CreateRemoteNetwork(ctx, &sdk.RemoteNetwork{Name: "office"})
+	require.NoError(t, err)
+
+	connector, err := c.CreateConnector(ctx, &sdk.Connector{RemoteNetworkID: remoteNetwork.ID})
+	require.NoError(t, err)
+	assert.NotEmpty(t, connector.Name)
+
+	tokens, err := c.GenerateConnectorTokens(ctx, connector.ID)
+	require.NoError(t, err)
+
+	internal := newInternalClient(t, server)
+
+	assert.NoError(t, internal.VerifyConnectorTokens(ctx, tokens.RefreshToken, tokens.AccessToken))
+	assert.Error(t, internal.VerifyConnectorTokens(ctx, "invalid", tokens.AccessToken))
+}
+
+func TestUnauthorized(t *testing.T) {
+	server := NewServer(WithAPIToken("secret"))
+	defer server.Close()
+
+	c, err := server.NewClient(sdk.WithAPIToken("wrong"), sdk.WithHTTPMaxRetry(0))
+	require.NoError(t, err)
+
+	_, err = c.RemoteNetworks().Collect(context.Background())
+	assert.ErrorContains(t, err, "401")
+
+	resp, err := http.Post(server.URL+graphqlPath, "application/json", strings.NewReader("{}")) //nolint:noctx
+	require.NoError(t, err)
+	_ = resp.Body.Close()
+	assert.Equal(t, http.StatusUnauthorized, resp.StatusCode)
+}
@@ -0,0 +1,243 @@
+package fake
+
+import (
+	"encoding/base64"
+	"fmt"
+	"time"
+
+	"github.com/Twingate/terraform-provider-twingate/twingate/internal/model"
+	"github.com/Twingate/terraform-provider-twingate/twingate/internal/utils"
+)
+
+const (
+	defaultLocation       = "OTHER"
+	defaultSecurityPolicy = "Default Policy"
+	everyoneGroup         = "Everyone"
+
+	policyAllowAll = "ALLOW_ALL"
+
+	hoursInDay = 24
+)
+
+type remoteNetwork struct {
+	id       string
+	name     string
+	location string
+}
+
+type connector struct {
+	id                   string
+	name                 string
+	remoteNetworkID      string
+	statusUpdatesEnabled bool
+	accessToken          string
+	refreshToken         string
+}
+
+type resource struct {
+	id                       string
+	name                     string
+	address                  string
+	remoteNetworkID          string
+	protocols                protocols
+	groupIDs                 []string
+	isActive                 bool
+	isVisible                bool
+	isBrowserShortcutEnabled bool
+	alias                    string
+}
+
+type protocols struct {
+	allowIcmp bool
+	tcp       protocol
+	udp       protocol
+}
+
+type protocol struct {
+	policy string
+	ports  [][2]int
+}
+
+type group struct {
+	id               string
+	name             string
+	groupType        string
+	isActive         bool
+	userIDs          []string
+	securityPolicyID string
+}
+
+type user struct {
+	id        string
+	firstName string
+	lastName  string
+	email     string
+	role      string
+	userType  string
+	state     string
+}
+
+type serviceAccount struct {
+	id          string
+	name        string
+	resourceIDs []string
+}
+
+type serviceKey struct {
+	id               string
+	name             string
+	serviceAccountID string
+	expiresAt        string
+	status           string
+}
+
+type securityPolicy struct {
+	id   string
+	name string
+}
+
+// store - objects of the network, kept in creation order which is also the order of the query results.
+type store struct {
+	lastID int
+
+	remoteNetworks   []*remoteNetwork
+	connectors       []*connector
+	resources        []*resource
+	groups           []*group
+	users            []*user
+	serviceAccounts  []*serviceAccount
+	serviceKeys      []*serviceKey
+	securityPolicies []*securityPolicy
+}
+
+func newStore() *store {
+	s := &store{}
+
+	policy := &securityPolicy{id: s.newID("SecurityPolicy"), name: defaultSecurityPolicy}
+	s.securityPolicies = append(s.securityPolicies, policy)
+
+	s.groups = append(s.groups, &group{
+		id:               s.newID("Group"),
+		name:             everyoneGroup,
+		groupType:        model.GroupTypeSystem,
+		isActive:         true,
+		securityPolicyID: policy.id,
+	})
+
+	return s
+}
+
+// newID - IDs look like the real ones, base64 encoded type name and number.
+func (s *store) newID(typeName string) string {
+	s.lastID++
+
+	return base64.StdEncoding.EncodeToString([]byte(fmt.Sprintf("%s:%d", typeName, s.lastID)))
+}
+
+func (s *store) newToken(typeName string) string {
+	s.lastID++
+
+	return base64.RawURLEncoding.EncodeToString([]byte(fmt.Sprintf("%s-token-%d", typeName, s.lastID)))
+}
+
+func (s *store) defaultSecurityPolicyID() string {
+	return s.securityPolicies[0].id
+}
+
+func defaultProtocols() protocols {
+	return protocols{
+		allowIcmp: true,
+		tcp:       protocol{policy: policyAllowAll},
+		udp:       protocol{policy: policyAllowAll},
+	}
+}
+
+func parseProtocols(value interface{}) protocols {
+	input, ok := value.(map[string]interface{})
+	if !ok {
+		return defaultProtocols()
+	}
+
+	result := defaultProtocols()
+
+	if allowIcmp, ok := boolArg(input, "allowIcmp"); ok {
+		result.allowIcmp = allowIcmp
+	}
+
+	result.tcp = parseProtocol(input["tcp"])
+	result.udp = parseProtocol(input["udp"])
+
+	return result
+}
+
+func parseProtocol(value interface{}) protocol {
+	input, ok := value.(map[string]interface{})
+	if !ok {
+		return protocol{policy: policyAllowAll}
+	}
+
+	result := protocol{policy: stringArg(input, "policy")}
+	if result.policy == "" {
+		result.policy = policyAllowAll
+	}
+
+	ports, _ := input["ports"].([]interface{})
+	for _, port := range ports {
+		portRange, ok := port.(map[string]interface{})
+		if !ok {
+			continue
+		}
+
+		start, _ := portRange["start"].(float64)
+		end, _ := portRange["end"].(float64)
+
+		result.ports = append(result.ports, [2]int{int(start), int(end)})
+	}
+
+	return result
+}
+
+// expiresAt - expiration time of a key created now and valid for the given number of days, zero means it never expires.
+func expiresAt(days int) string {
+	if days <= 0 {
+		return ""
+	}
+
+	return time.Now().UTC().Add(time.Duration(days) * hoursInDay * time.Hour).Format(time.RFC3339)
+}
+
+func findByID[T any](items []*T, id string, getID func(item *T) string) *T {
+	for _, item := range items {
+		if getID(item) == id {
+			return item
+		}
+	}
+
+	return nil
+}
+
+func removeByID[T any](items []*T, id string, getID func(item *T) string) ([]*T, bool) {
+	for i, item := range items {
+		if getID(item) == id {
+			return append(items[:i:i], items[i+1:]...), true
+		}
+	}
+
+	return items, false
+}
+
+func addStrings(items []string, values []string) []string {
+	for _, value := range values {
+		if !utils.Contains(items, value) {
+			items = append(items, value)
+		}
+	}
+
+	return items
+}
+
+func removeStrings(items []string, values []string) []string {
+	return utils.Filter(items, func(item string) bool {
+		return !utils.Contains(values, item)
+	})
+}
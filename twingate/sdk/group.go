@@ -0,0 +1,82 @@
+package sdk
+
+import (
+	"context"
+
+	"github.com/Twingate/terraform-provider-twingate/twingate/internal/model"
+)
+
+func newGroup(group *model.Group) *Group {
+	if group == nil {
+		return nil
+	}
+
+	return &Group{
+		ID:               group.ID,
+		Name:             group.Name,
+		Type:             group.Type,
+		IsActive:         group.IsActive,
+		Users:            group.Users,
+		SecurityPolicyID: group.SecurityPolicyID,
+	}
+}
+
+func (g *Group) toModel() *model.Group {
+	if g == nil {
+		return nil
+	}
+
+	return &model.Group{
+		ID:               g.ID,
+		Name:             g.Name,
+		Type:             g.Type,
+		IsActive:         g.IsActive,
+		Users:            g.Users,
+		SecurityPolicyID: g.SecurityPolicyID,
+	}
+}
+
+func (f *GroupsFilter) toModel() *model.GroupsFilter {
+	if f == nil {
+		return nil
+	}
+
+	return &model.GroupsFilter{
+		Name:     f.Name,
+		Type:     f.Type,
+		IsActive: f.IsActive,
+	}
+}
+
+// CreateGroup - creates a group. Requests which time out are recovered by looking up a group with the same name.
+func (c *Client) CreateGroup(ctx context.Context, group *Group) (*Group, error) {
+	created, err := c.client.CreateGroup(ctx, group.toModel())
+
+	return newGroup(created), wrapError(err)
+}
+
+func (c *Client) ReadGroup(ctx context.Context, groupID string) (*Group, error) {
+	group, err := c.client.ReadGroup(ctx, groupID)
+
+	return newGroup(group), wrapError(err)
+}
+
+func (c *Client) UpdateGroup(ctx context.Context, group *Group) (*Group, error) {
+	updated, err := c.client.UpdateGroup(ctx, group.toModel())
+
+	return newGroup(updated), wrapError(err)
+}
+
+func (c *Client) DeleteGroup(ctx context.Context, groupID string) error {
+	return wrapError(c.client.DeleteGroup(ctx, groupID))
+}
+
+// RemoveGroupUsers - removes the users from the group.
+func (c *Client) RemoveGroupUsers(ctx context.Context, groupID string, userIDs []string) error {
+	return wrapError(c.client.DeleteGroupUsers(ctx, groupID, userIDs))
+}
+
+// Groups - iterates over groups matching the filter, including all their users, nil matches all groups.
+func (c *Client) Groups(filter *GroupsFilter) *Iterator[*Group] {
+	return newIterator(mapPages(c.client.FullGroupPages(filter.toModel()), newGroup))
+}
@@ -0,0 +1,109 @@
+package sdk
+
+import (
+	"context"
+	"errors"
+
+	"github.com/Twingate/terraform-provider-twingate/twingate/internal/client"
+)
+
+// Iterator - iterates over a list of objects, reading the next page of the list with the context of Next
+// only once all objects of the previous page are iterated.
+//
+//	it := c.RemoteNetworks()
+//	for it.Next(ctx) {
+//		network := it.Value()
+//	}
+//	if err := it.Err(); err != nil {
+//		...
+//	}
+type Iterator[T any] struct {
+	nextPage func(ctx context.Context) ([]T, bool, error)
+	items    []T
+	index    int
+	done     bool
+	err      error
+}
+
+func newIterator[T any](nextPage func(ctx context.Context) ([]T, bool, error)) *Iterator[T] {
+	return &Iterator[T]{nextPage: nextPage, index: -1}
+}
+
+// Next - advances to the next object, returns false when there are no more objects,
+// the context is done or reading failed.
+func (it *Iterator[T]) Next(ctx context.Context) bool {
+	if it.err != nil || it.done {
+		return false
+	}
+
+	if err := ctx.Err(); err != nil {
+		it.err = err
+
+		return false
+	}
+
+	// pages may be empty, so read until there is an object or no more pages
+	for it.index+1 >= len(it.items) {
+		items, ok, err := it.nextPage(ctx)
+		if err != nil && !errors.Is(err, ErrNotFound) {
+			it.err = err
+
+			return false
+		}
+
+		if err != nil || !ok {
+			it.done = true
+
+			return false
+		}
+
+		it.items = items
+		it.index = -1
+	}
+
+	it.index++
+
+	return true
+}
+
+// Value - the current object.
+func (it *Iterator[T]) Value() T {
+	var zero T
+	if it.index < 0 || it.index >= len(it.items) {
+		return zero
+	}
+
+	return it.items[it.index]
+}
+
+// Err - the error which stopped iteration, nil when all objects were iterated.
+func (it *Iterator[T]) Err() error {
+	return it.err
+}
+
+// Collect - all remaining objects.
+func (it *Iterator[T]) Collect(ctx context.Context) ([]T, error) {
+	var result []T
+	for it.Next(ctx) {
+		result = append(result, it.Value())
+	}
+
+	return result, it.Err()
+}
+
+// mapPages - converts the objects read by the internal client into sdk objects, wrapping its errors.
+func mapPages[M, T any](pages *client.Pages[M], convert func(M) T) func(ctx context.Context) ([]T, bool, error) {
+	return func(ctx context.Context) ([]T, bool, error) {
+		items, ok, err := pages.Next(ctx)
+		if err != nil || !ok {
+			return nil, false, wrapError(err)
+		}
+
+		result := make([]T, 0, len(items))
+		for _, item := range items {
+			result = append(result, convert(item))
+		}
+
+		return result, true, nil
+	}
+}
@@ -0,0 +1,126 @@
+package sdk
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+type ctxKey struct{}
+
+// pagesOf - returns the pages one by one, followed by err.
+func pagesOf(pages [][]int, err error, reads *int) func(ctx context.Context) ([]int, bool, error) {
+	return func(ctx context.Context) ([]int, bool, error) {
+		*reads++
+
+		if len(pages) == 0 {
+			return nil, false, err
+		}
+
+		page := pages[0]
+		pages = pages[1:]
+
+		return page, true, nil
+	}
+}
+
+func TestIterator(t *testing.T) {
+	canceled, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	cases := []struct {
+		ctx           context.Context
+		pages         [][]int
+		err           error
+		expected      []int
+		expectedErr   error
+		expectedReads int
+	}{
+		{
+			ctx:           context.Background(),
+			pages:         [][]int{{1, 2}, {}, {3}},
+			expected:      []int{1, 2, 3},
+			expectedReads: 4,
+		},
+		{
+			ctx:           context.Background(),
+			err:           ErrNotFound,
+			expectedReads: 1,
+		},
+		{
+			ctx:           context.Background(),
+			pages:         [][]int{{1}},
+			err:           errBadRequest,
+			expected:      []int{1},
+			expectedErr:   errBadRequest,
+			expectedReads: 2,
+		},
+		{
+			ctx:           canceled,
+			pages:         [][]int{{1}},
+			expectedErr:   context.Canceled,
+			expectedReads: 0,
+		},
+	}
+
+	for n, c := range cases {
+		t.Run(fmt.Sprintf("case_%d", n), func(t *testing.T) {
+			reads := 0
+			it := newIterator(pagesOf(c.pages, c.err, &reads))
+
+			actual, err := it.Collect(c.ctx)
+
+			assert.Equal(t, c.expected, actual)
+			assert.True(t, errors.Is(err, c.expectedErr))
+			assert.False(t, it.Next(c.ctx))
+			assert.Equal(t, c.expectedReads, reads)
+		})
+	}
+}
+
+func TestIteratorReadsPagesOnDemand(t *testing.T) {
+	var contexts []context.Context
+
+	pages := [][]int{{1, 2}, {3}}
+	it := newIterator(func(ctx context.Context) ([]int, bool, error) {
+		contexts = append(contexts, ctx)
+
+		if len(contexts) > len(pages) {
+			return nil, false, nil
+		}
+
+		return pages[len(contexts)-1], true, nil
+	})
+
+	first := context.WithValue(context.Background(), ctxKey{}, "first")
+	second := context.WithValue(context.Background(), ctxKey{}, "second")
+
+	assert.True(t, it.Next(first))
+	assert.True(t, it.Next(first))
+	assert.Equal(t, 2, it.Value())
+	assert.Equal(t, []context.Context{first}, contexts)
+
+	assert.True(t, it.Next(second))
+	assert.Equal(t, 3, it.Value())
+	assert.Equal(t, []context.Context{first, second}, contexts)
+}
+
+func TestIteratorStopsWhenContextIsDone(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+
+	reads := 0
+	it := newIterator(pagesOf([][]int{{1}, {2}}, nil, &reads))
+
+	assert.True(t, it.Next(ctx))
+	assert.Equal(t, 1, it.Value())
+
+	cancel()
+
+	assert.False(t, it.Next(ctx))
+	assert.ErrorIs(t, it.Err(), context.Canceled)
+	assert.Equal(t, 1, it.Value())
+	assert.Equal(t, 1, reads)
+}
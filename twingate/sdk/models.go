@@ -0,0 +1,219 @@
+package sdk
+
+import (
+	"errors"
+
+	"github.com/Twingate/terraform-provider-twingate/twingate/internal/client"
+)
+
+// Models of the Twingate API.
+type (
+	RemoteNetwork struct {
+		ID       string
+		Name     string
+		Location string
+	}
+
+	Connector struct {
+		ID                   string
+		Name                 string
+		RemoteNetworkID      string
+		StatusUpdatesEnabled *bool
+	}
+
+	ConnectorTokens struct {
+		AccessToken  string
+		RefreshToken string
+	}
+
+	Resource struct {
+		ID                       string
+		Name                     string
+		Address                  string
+		Alias                    *string
+		RemoteNetworkID          string
+		Protocols                *Protocols
+		IsActive                 bool
+		IsVisible                *bool
+		IsBrowserShortcutEnabled *bool
+		// Groups - IDs of the groups with access to the resource, these are added to the existing ones on update,
+		// use RemoveResourceGroups to remove access. Service accounts are given access through UpdateServiceAccount.
+		Groups []string
+	}
+
+	// Protocols - nil allows all protocols and ports.
+	Protocols struct {
+		AllowIcmp bool
+		TCP       *Protocol
+		UDP       *Protocol
+	}
+
+	Protocol struct {
+		// Policy - one of PolicyRestricted, PolicyAllowAll or PolicyDenyAll.
+		Policy string
+		// Ports - allowed ports of the restricted policy.
+		Ports []PortRange
+	}
+
+	PortRange struct {
+		Start int
+		End   int
+	}
+
+	Group struct {
+		ID       string
+		Name     string
+		Type     string
+		IsActive bool
+		// Users - IDs of the group members, these are added to the existing ones on update,
+		// use RemoveGroupUsers to remove members.
+		Users            []string
+		SecurityPolicyID string
+	}
+
+	// GroupsFilter - nil fields match all groups.
+	GroupsFilter struct {
+		Name     *string
+		Type     *string
+		IsActive *bool
+	}
+
+	User struct {
+		ID         string
+		FirstName  string
+		LastName   string
+		Email      string
+		Role       string
+		Type       string
+		SendInvite bool
+		IsActive   bool
+	}
+
+	// UserUpdate - nil fields are not changed.
+	UserUpdate struct {
+		ID        string
+		FirstName *string
+		LastName  *string
+		Role      *string
+		IsActive  *bool
+	}
+
+	ServiceAccount struct {
+		ID   string
+		Name string
+		// Resources - IDs of the resources the service account has access to, these are added to the existing ones
+		// on update, use RemoveServiceAccountResources to remove access.
+		Resources []string
+		// Keys - IDs of the active keys.
+		Keys []string
+	}
+
+	ServiceKey struct {
+		ID               string
+		Name             string
+		ServiceAccountID string
+		Status           string
+		// ExpirationTime - in days, 0 for keys which never expire.
+		ExpirationTime int
+		// Token - only returned on create.
+		Token string
+	}
+
+	SecurityPolicy struct {
+		ID   string
+		Name string
+	}
+)
+
+// Error classes of the API errors.
+var (
+	ErrNotFound         = client.ErrNotFound
+	ErrPermissionDenied = client.ErrPermissionDenied
+	ErrConflict         = client.ErrConflict
+	ErrValidation       = client.ErrValidation
+)
+
+// Errors of invalid settings returned by NewClient.
+var (
+	ErrNetworkNotSet         = client.ErrNetworkNotSet
+	ErrInvalidProxyURL       = client.ErrInvalidProxyURL
+	ErrInvalidCACert         = client.ErrInvalidCACert
+	ErrClientCertKeyMismatch = client.ErrClientCertKeyMismatch
+)
+
+// APIError - returned by all operations of the Client, use errors.Is with the error classes above to handle it.
+type APIError struct {
+	// Operation - e.g. `create`, `read`.
+	Operation string
+	// Resource - type of the object, e.g. `remote network`.
+	Resource string
+	ID       string
+	Name     string
+	// Class - one of ErrNotFound, ErrPermissionDenied, ErrConflict, ErrValidation, or nil if unknown.
+	Class error
+	// Field - name of the API input field the error refers to, if reported by the API.
+	Field string
+
+	message string
+	err     error
+}
+
+func (e *APIError) Error() string {
+	return e.message
+}
+
+func (e *APIError) Unwrap() error {
+	return e.err
+}
+
+// Is - matches the error class, e.g. `errors.Is(err, sdk.ErrNotFound)`.
+func (e *APIError) Is(target error) bool {
+	return e.Class != nil && e.Class == target //nolint:errorlint,goerr113
+}
+
+// wrapError - converts errors of the internal client into APIError.
+func wrapError(err error) error {
+	var apiErr *client.APIError
+	if !errors.As(err, &apiErr) {
+		return err
+	}
+
+	return &APIError{
+		Operation: apiErr.Operation,
+		Resource:  apiErr.Resource,
+		ID:        string(apiErr.ID),
+		Name:      apiErr.Name,
+		Class:     apiErr.Class,
+		Field:     apiErr.Field,
+		message:   err.Error(),
+		err:       apiErr.WrappedError,
+	}
+}
+
+// Enum values of the models.
+const (
+	LocationAWS         = "AWS"
+	LocationAzure       = "AZURE"
+	LocationGoogleCloud = "GOOGLE_CLOUD"
+	LocationOnPremise   = "ON_PREMISE"
+	LocationOther       = "OTHER"
+
+	PolicyRestricted = "RESTRICTED"
+	PolicyAllowAll   = "ALLOW_ALL"
+	PolicyDenyAll    = "DENY_ALL"
+
+	GroupTypeManual = "MANUAL"
+	GroupTypeSynced = "SYNCED"
+	GroupTypeSystem = "SYSTEM"
+
+	UserRoleAdmin   = "ADMIN"
+	UserRoleDevops  = "DEVOPS"
+	UserRoleSupport = "SUPPORT"
+	UserRoleMember  = "MEMBER"
+
+	UserTypeManual = "MANUAL"
+	UserTypeSynced = "SYNCED"
+
+	ServiceKeyStatusActive  = "ACTIVE"
+	ServiceKeyStatusRevoked = "REVOKED"
+)
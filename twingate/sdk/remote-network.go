@@ -0,0 +1,64 @@
+package sdk
+
+import (
+	"context"
+
+	"github.com/Twingate/terraform-provider-twingate/twingate/internal/model"
+)
+
+func newRemoteNetwork(network *model.RemoteNetwork) *RemoteNetwork {
+	if network == nil {
+		return nil
+	}
+
+	return &RemoteNetwork{
+		ID:       network.ID,
+		Name:     network.Name,
+		Location: network.Location,
+	}
+}
+
+func (n *RemoteNetwork) toModel() *model.RemoteNetwork {
+	if n == nil {
+		return nil
+	}
+
+	return &model.RemoteNetwork{
+		ID:       n.ID,
+		Name:     n.Name,
+		Location: n.Location,
+	}
+}
+
+func (c *Client) CreateRemoteNetwork(ctx context.Context, network *RemoteNetwork) (*RemoteNetwork, error) {
+	created, err := c.client.CreateRemoteNetwork(ctx, network.toModel())
+
+	return newRemoteNetwork(created), wrapError(err)
+}
+
+func (c *Client) ReadRemoteNetwork(ctx context.Context, networkID string) (*RemoteNetwork, error) {
+	network, err := c.client.ReadRemoteNetworkByID(ctx, networkID)
+
+	return newRemoteNetwork(network), wrapError(err)
+}
+
+func (c *Client) ReadRemoteNetworkByName(ctx context.Context, name string) (*RemoteNetwork, error) {
+	network, err := c.client.ReadRemoteNetworkByName(ctx, name)
+
+	return newRemoteNetwork(network), wrapError(err)
+}
+
+func (c *Client) UpdateRemoteNetwork(ctx context.Context, network *RemoteNetwork) (*RemoteNetwork, error) {
+	updated, err := c.client.UpdateRemoteNetwork(ctx, network.toModel())
+
+	return newRemoteNetwork(updated), wrapError(err)
+}
+
+func (c *Client) DeleteRemoteNetwork(ctx context.Context, networkID string) error {
+	return wrapError(c.client.DeleteRemoteNetwork(ctx, networkID))
+}
+
+// RemoteNetworks - iterates over all remote networks.
+func (c *Client) RemoteNetworks() *Iterator[*RemoteNetwork] {
+	return newIterator(mapPages(c.client.RemoteNetworkPages(), newRemoteNetwork))
+}
@@ -0,0 +1,127 @@
+package sdk
+
+import (
+	"context"
+
+	"github.com/Twingate/terraform-provider-twingate/twingate/internal/model"
+	"github.com/Twingate/terraform-provider-twingate/twingate/internal/utils"
+)
+
+func newResource(resource *model.Resource) *Resource {
+	if resource == nil {
+		return nil
+	}
+
+	return &Resource{
+		ID:                       resource.ID,
+		Name:                     resource.Name,
+		Address:                  resource.Address,
+		Alias:                    resource.Alias,
+		RemoteNetworkID:          resource.RemoteNetworkID,
+		Protocols:                newProtocols(resource.Protocols),
+		IsActive:                 resource.IsActive,
+		IsVisible:                resource.IsVisible,
+		IsBrowserShortcutEnabled: resource.IsBrowserShortcutEnabled,
+		Groups:                   resource.Groups,
+	}
+}
+
+func (r *Resource) toModel() *model.Resource {
+	if r == nil {
+		return nil
+	}
+
+	return &model.Resource{
+		ID:                       r.ID,
+		Name:                     r.Name,
+		Address:                  r.Address,
+		Alias:                    r.Alias,
+		RemoteNetworkID:          r.RemoteNetworkID,
+		Protocols:                r.Protocols.toModel(),
+		IsActive:                 r.IsActive,
+		IsVisible:                r.IsVisible,
+		IsBrowserShortcutEnabled: r.IsBrowserShortcutEnabled,
+		Groups:                   r.Groups,
+	}
+}
+
+func newProtocols(protocols *model.Protocols) *Protocols {
+	if protocols == nil {
+		return nil
+	}
+
+	return &Protocols{
+		AllowIcmp: protocols.AllowIcmp,
+		TCP:       newProtocol(protocols.TCP),
+		UDP:       newProtocol(protocols.UDP),
+	}
+}
+
+func (p *Protocols) toModel() *model.Protocols {
+	if p == nil {
+		return nil
+	}
+
+	return &model.Protocols{
+		AllowIcmp: p.AllowIcmp,
+		TCP:       p.TCP.toModel(),
+		UDP:       p.UDP.toModel(),
+	}
+}
+
+func newProtocol(protocol *model.Protocol) *Protocol {
+	if protocol == nil {
+		return nil
+	}
+
+	result := &Protocol{Policy: protocol.Policy}
+	for _, port := range protocol.Ports {
+		result.Ports = append(result.Ports, PortRange{Start: port.Start, End: port.End})
+	}
+
+	return result
+}
+
+func (p *Protocol) toModel() *model.Protocol {
+	if p == nil {
+		return nil
+	}
+
+	return model.NewProtocol(p.Policy, utils.Map[PortRange, *model.PortRange](p.Ports, func(port PortRange) *model.PortRange {
+		return &model.PortRange{Start: port.Start, End: port.End}
+	}))
+}
+
+// CreateResource - creates a resource, giving access to its groups. Requests which time out are recovered
+// by looking up a resource with the same name, address and remote network.
+func (c *Client) CreateResource(ctx context.Context, resource *Resource) (*Resource, error) {
+	created, err := c.client.CreateResource(ctx, resource.toModel())
+
+	return newResource(created), wrapError(err)
+}
+
+func (c *Client) ReadResource(ctx context.Context, resourceID string) (*Resource, error) {
+	resource, err := c.client.ReadResource(ctx, resourceID)
+
+	return newResource(resource), wrapError(err)
+}
+
+func (c *Client) UpdateResource(ctx context.Context, resource *Resource) (*Resource, error) {
+	updated, err := c.client.UpdateResource(ctx, resource.toModel())
+
+	return newResource(updated), wrapError(err)
+}
+
+func (c *Client) DeleteResource(ctx context.Context, resourceID string) error {
+	return wrapError(c.client.DeleteResource(ctx, resourceID))
+}
+
+// RemoveResourceGroups - removes access of the groups to the resource.
+func (c *Client) RemoveResourceGroups(ctx context.Context, resourceID string, groupIDs []string) error {
+	return wrapError(c.client.DeleteResourceGroups(ctx, resourceID, groupIDs))
+}
+
+// Resources - iterates over all resources, including their protocols and groups.
+func (c *Client) Resources() *Iterator[*Resource] {
+	return newIterator(mapPages(c.client.FullResourcePages(), newResource))
+}
@@ -0,0 +1,35 @@
+package sdk
+
+import (
+	"context"
+
+	"github.com/Twingate/terraform-provider-twingate/twingate/internal/model"
+)
+
+func newSecurityPolicy(policy *model.SecurityPolicy) *SecurityPolicy {
+	if policy == nil {
+		return nil
+	}
+
+	return &SecurityPolicy{
+		ID:   policy.ID,
+		Name: policy.Name,
+	}
+}
+
+func (c *Client) ReadSecurityPolicy(ctx context.Context, policyID string) (*SecurityPolicy, error) {
+	policy, err := c.client.ReadSecurityPolicy(ctx, policyID, "")
+
+	return newSecurityPolicy(policy), wrapError(err)
+}
+
+func (c *Client) ReadSecurityPolicyByName(ctx context.Context, name string) (*SecurityPolicy, error) {
+	policy, err := c.client.ReadSecurityPolicy(ctx, "", name)
+
+	return newSecurityPolicy(policy), wrapError(err)
+}
+
+// SecurityPolicies - iterates over all security policies.
+func (c *Client) SecurityPolicies() *Iterator[*SecurityPolicy] {
+	return newIterator(mapPages(c.client.SecurityPolicyPages(), newSecurityPolicy))
+}
@@ -0,0 +1,66 @@
+package sdk
+
+import (
+	"context"
+
+	"github.com/Twingate/terraform-provider-twingate/twingate/internal/model"
+)
+
+func newServiceAccount(account *model.ServiceAccount) *ServiceAccount {
+	if account == nil {
+		return nil
+	}
+
+	return &ServiceAccount{
+		ID:        account.ID,
+		Name:      account.Name,
+		Resources: account.Resources,
+		Keys:      account.Keys,
+	}
+}
+
+func (s *ServiceAccount) toModel() *model.ServiceAccount {
+	if s == nil {
+		return nil
+	}
+
+	return &model.ServiceAccount{
+		ID:        s.ID,
+		Name:      s.Name,
+		Resources: s.Resources,
+		Keys:      s.Keys,
+	}
+}
+
+func (c *Client) CreateServiceAccount(ctx context.Context, name string) (*ServiceAccount, error) {
+	created, err := c.client.CreateServiceAccount(ctx, name)
+
+	return newServiceAccount(created), wrapError(err)
+}
+
+func (c *Client) ReadServiceAccount(ctx context.Context, serviceAccountID string) (*ServiceAccount, error) {
+	account, err := c.client.ReadServiceAccount(ctx, serviceAccountID)
+
+	return newServiceAccount(account), wrapError(err)
+}
+
+// UpdateServiceAccount - renames the service account and gives it access to the resources, keys are ignored.
+func (c *Client) UpdateServiceAccount(ctx context.Context, account *ServiceAccount) (*ServiceAccount, error) {
+	updated, err := c.client.UpdateServiceAccount(ctx, account.toModel())
+
+	return newServiceAccount(updated), wrapError(err)
+}
+
+func (c *Client) DeleteServiceAccount(ctx context.Context, serviceAccountID string) error {
+	return wrapError(c.client.DeleteServiceAccount(ctx, serviceAccountID))
+}
+
+// RemoveServiceAccountResources - removes access of the service account to the resources.
+func (c *Client) RemoveServiceAccountResources(ctx context.Context, serviceAccountID string, resourceIDs []string) error {
+	return wrapError(c.client.UpdateServiceAccountRemoveResources(ctx, serviceAccountID, resourceIDs))
+}
+
+// ServiceAccounts - iterates over all service accounts, including their resources and keys.
+func (c *Client) ServiceAccounts() *Iterator[*ServiceAccount] {
+	return newIterator(mapPages(c.client.ServiceAccountPages(), newServiceAccount))
+}
@@ -0,0 +1,65 @@
+package sdk
+
+import (
+	"context"
+
+	"github.com/Twingate/terraform-provider-twingate/twingate/internal/model"
+)
+
+func newServiceKey(key *model.ServiceKey) *ServiceKey {
+	if key == nil {
+		return nil
+	}
+
+	return &ServiceKey{
+		ID:               key.ID,
+		Name:             key.Name,
+		ServiceAccountID: key.Service,
+		Status:           key.Status,
+		ExpirationTime:   key.ExpirationTime,
+		Token:            key.Token,
+	}
+}
+
+func (k *ServiceKey) toModel() *model.ServiceKey {
+	if k == nil {
+		return nil
+	}
+
+	return &model.ServiceKey{
+		ID:             k.ID,
+		Name:           k.Name,
+		Service:        k.ServiceAccountID,
+		Status:         k.Status,
+		ExpirationTime: k.ExpirationTime,
+	}
+}
+
+// CreateServiceKey - creates a key of the service account, the token is only returned here.
+func (c *Client) CreateServiceKey(ctx context.Context, key *ServiceKey) (*ServiceKey, error) {
+	created, err := c.client.CreateServiceKey(ctx, key.toModel())
+
+	return newServiceKey(created), wrapError(err)
+}
+
+func (c *Client) ReadServiceKey(ctx context.Context, keyID string) (*ServiceKey, error) {
+	key, err := c.client.ReadServiceKey(ctx, keyID)
+
+	return newServiceKey(key), wrapError(err)
+}
+
+// UpdateServiceKey - renames the key, other fields can't be changed.
+func (c *Client) UpdateServiceKey(ctx context.Context, key *ServiceKey) (*ServiceKey, error) {
+	updated, err := c.client.UpdateServiceKey(ctx, key.toModel())
+
+	return newServiceKey(updated), wrapError(err)
+}
+
+// RevokeServiceKey - revokes the key, revoked keys can only be deleted.
+func (c *Client) RevokeServiceKey(ctx context.Context, keyID string) error {
+	return wrapError(c.client.RevokeServiceKey(ctx, keyID))
+}
+
+func (c *Client) DeleteServiceKey(ctx context.Context, keyID string) error {
+	return wrapError(c.client.DeleteServiceKey(ctx, keyID))
+}
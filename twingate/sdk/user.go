@@ -0,0 +1,82 @@
+package sdk
+
+import (
+	"context"
+
+	"github.com/Twingate/terraform-provider-twingate/twingate/internal/model"
+)
+
+func newUser(user *model.User) *User {
+	if user == nil {
+		return nil
+	}
+
+	return &User{
+		ID:         user.ID,
+		FirstName:  user.FirstName,
+		LastName:   user.LastName,
+		Email:      user.Email,
+		Role:       user.Role,
+		Type:       user.Type,
+		SendInvite: user.SendInvite,
+		IsActive:   user.IsActive,
+	}
+}
+
+func (u *User) toModel() *model.User {
+	if u == nil {
+		return nil
+	}
+
+	return &model.User{
+		ID:         u.ID,
+		FirstName:  u.FirstName,
+		LastName:   u.LastName,
+		Email:      u.Email,
+		Role:       u.Role,
+		Type:       u.Type,
+		SendInvite: u.SendInvite,
+		IsActive:   u.IsActive,
+	}
+}
+
+func (u *UserUpdate) toModel() *model.UserUpdate {
+	if u == nil {
+		return nil
+	}
+
+	return &model.UserUpdate{
+		ID:        u.ID,
+		FirstName: u.FirstName,
+		LastName:  u.LastName,
+		Role:      u.Role,
+		IsActive:  u.IsActive,
+	}
+}
+
+func (c *Client) CreateUser(ctx context.Context, user *User) (*User, error) {
+	created, err := c.client.CreateUser(ctx, user.toModel())
+
+	return newUser(created), wrapError(err)
+}
+
+func (c *Client) ReadUser(ctx context.Context, userID string) (*User, error) {
+	user, err := c.client.ReadUser(ctx, userID)
+
+	return newUser(user), wrapError(err)
+}
+
+func (c *Client) UpdateUser(ctx context.Context, update *UserUpdate) (*User, error) {
+	updated, err := c.client.UpdateUser(ctx, update.toModel())
+
+	return newUser(updated), wrapError(err)
+}
+
+func (c *Client) DeleteUser(ctx context.Context, userID string) error {
+	return wrapError(c.client.DeleteUser(ctx, userID))
+}
+
+// Users - iterates over all users.
+func (c *Client) Users() *Iterator[*User] {
+	return newIterator(mapPages(c.client.UserPages(), newUser))
+}
@@ -0,0 +1,14 @@
+package twingate
+
+import (
+	"context"
+
+	"github.com/Twingate/terraform-provider-twingate/twingate/internal/tracing"
+)
+
+// SetupTracing - enables OpenTelemetry tracing of the provider when an OTLP endpoint is set with
+// OTEL_EXPORTER_OTLP_ENDPOINT or OTEL_EXPORTER_OTLP_TRACES_ENDPOINT, tracing is off otherwise.
+// Call the returned func before exit to export the remaining spans.
+func SetupTracing(ctx context.Context, version string) (func(context.Context) error, error) {
+	return tracing.Setup(ctx, version) //nolint:wrapcheck
+}